@@ -0,0 +1,382 @@
+package reforge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ReforgeHQ/sdk-go/internal/utils"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+const (
+	bindKeyTag     = "reforge"
+	bindDefaultTag = "default"
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// Binding keeps a struct populated from the configs under a key prefix. Each
+// update publishes a fresh struct, so a value returned by Load is never
+// modified afterwards and can be read without locking.
+type Binding[T any] struct {
+	client      *Client
+	current     atomic.Pointer[T]
+	lastErr     atomic.Pointer[error]
+	unsubscribe func()
+	prefix      string
+	fields      []boundField
+	reloadMutex sync.Mutex
+}
+
+type boundField struct {
+	fieldType    reflect.Type
+	name         string
+	key          string
+	defaultValue string
+	index        int
+	hasDefault   bool
+}
+
+// Bind fills the struct target points at from the configs under prefix and
+// keeps it up to date as changes arrive from the API. Fields are mapped with a
+// `reforge` tag holding the key relative to prefix, and may carry a `default`
+// tag used when the key is missing:
+//
+//	type PaymentsConfig struct {
+//		MaxRetries int           `reforge:"retry.max" default:"3"`
+//		Timeout    time.Duration `reforge:"timeout" default:"5s"`
+//		Providers  []string      `reforge:"providers" default:"stripe,adyen"`
+//		Limits     Limits        `reforge:"limits"` // decoded from a JSON config
+//	}
+//
+//	binding, err := reforge.Bind(client, "payments.", &PaymentsConfig{})
+//	maxRetries := binding.Load().MaxRetries
+//
+// Keys are evaluated against the client's global context. Fields without a
+// `reforge` tag are left as they are in target.
+//
+// Every field that is missing without a default or holds a value of the wrong
+// type is reported in a single joined error; those fields keep their previous
+// value. The binding is returned alongside that error and keeps updating, so
+// callers may choose to log the error and carry on. Err reports the problems
+// found by the most recent update.
+func Bind[T any](client *Client, prefix string, target *T) (*Binding[T], error) {
+	if target == nil {
+		return nil, errors.New("bind target must be a non-nil pointer to a struct")
+	}
+
+	fields, err := bindFields(reflect.TypeOf(target).Elem())
+	if err != nil {
+		return nil, err
+	}
+
+	if client.closed.Load() {
+		return nil, ErrClientClosed
+	}
+
+	binding := &Binding[T]{client: client, prefix: prefix, fields: fields}
+
+	// Subscribe before the first load so a change landing in between isn't missed
+	binding.unsubscribe = client.OnChange(prefix, func(ChangeEvent) {
+		binding.reload()
+	})
+
+	var problems []error
+
+	// Wait before taking the lock: the initial load's change notification calls reload
+	if err := client.readyForEvaluation(context.Background()); err != nil {
+		problems = append(problems, err)
+	}
+
+	binding.reloadMutex.Lock()
+	defer binding.reloadMutex.Unlock()
+
+	problems = append(problems, binding.populate(target)...)
+	err = errors.Join(problems...)
+
+	binding.current.Store(target)
+	binding.lastErr.Store(&err)
+
+	return binding, err
+}
+
+// Load returns the most recently published value. Treat it as read-only.
+func (b *Binding[T]) Load() *T {
+	return b.current.Load()
+}
+
+// Err returns the joined error from the most recent update, or nil if every field was populated.
+func (b *Binding[T]) Err() error {
+	return *b.lastErr.Load()
+}
+
+// Close stops the binding from receiving updates. The last published value remains available from Load.
+func (b *Binding[T]) Close() {
+	b.unsubscribe()
+}
+
+func (b *Binding[T]) reload() {
+	b.reloadMutex.Lock()
+	defer b.reloadMutex.Unlock()
+
+	// The initial load publishes the first value; until then there is nothing to copy
+	current := b.current.Load()
+	if current == nil {
+		return
+	}
+
+	next := *current
+	err := errors.Join(b.populate(&next)...)
+
+	b.current.Store(&next)
+	b.lastErr.Store(&err)
+}
+
+func (b *Binding[T]) populate(target *T) []error {
+	structValue := reflect.ValueOf(target).Elem()
+
+	var problems []error
+
+	for _, field := range b.fields {
+		key := b.prefix + field.key
+
+		err := b.populateField(structValue.Field(field.index), field, key)
+		if err != nil {
+			problems = append(problems, fmt.Errorf("field %s (key %q): %w", field.name, key, err))
+		}
+	}
+
+	return problems
+}
+
+func (b *Binding[T]) populateField(fieldValue reflect.Value, field boundField, key string) error {
+	match, err := b.client.configResolver.ResolveValue(key, b.client.boundClient.context)
+	if err == nil && match.IsMatch && match.Match != nil {
+		value, err := valueFromConfig(match.Match, field.fieldType)
+		if err != nil {
+			return err
+		}
+
+		fieldValue.Set(value)
+
+		return nil
+	}
+
+	if !field.hasDefault {
+		return ErrKeyNotFound
+	}
+
+	value, err := valueFromDefault(field.defaultValue, field.fieldType)
+	if err != nil {
+		return fmt.Errorf("parsing default %q: %w", field.defaultValue, err)
+	}
+
+	fieldValue.Set(value)
+
+	return nil
+}
+
+func bindFields(structType reflect.Type) ([]boundField, error) {
+	if structType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("bind target must point to a struct, not %s", structType)
+	}
+
+	var fields []boundField
+
+	for i := range structType.NumField() {
+		structField := structType.Field(i)
+
+		key, ok := structField.Tag.Lookup(bindKeyTag)
+		if !ok || key == "-" {
+			continue
+		}
+
+		if !structField.IsExported() {
+			return nil, fmt.Errorf("field %s has a %s tag but is not exported", structField.Name, bindKeyTag)
+		}
+
+		defaultValue, hasDefault := structField.Tag.Lookup(bindDefaultTag)
+
+		fields = append(fields, boundField{
+			fieldType:    structField.Type,
+			name:         structField.Name,
+			key:          key,
+			defaultValue: defaultValue,
+			index:        i,
+			hasDefault:   hasDefault,
+		})
+	}
+
+	return fields, nil
+}
+
+// valueFromConfig converts a config value into a value assignable to a field of fieldType.
+// Struct, map and other slice fields are decoded from JSON configs.
+func valueFromConfig(cv *prefabProto.ConfigValue, fieldType reflect.Type) (reflect.Value, error) {
+	result := reflect.New(fieldType).Elem()
+	mismatch := func() error {
+		return fmt.Errorf("%w: holds a %s value, field is %s", ErrTypeMismatch, utils.GetValueType(cv), fieldType)
+	}
+
+	if fieldType == durationType {
+		duration, ok := utils.ExtractDurationValue(cv)
+		if !ok {
+			return result, mismatch()
+		}
+
+		result.SetInt(int64(duration))
+
+		return result, nil
+	}
+
+	switch fieldType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		intValue, ok := utils.ExtractIntValue(cv)
+		if !ok {
+			return result, mismatch()
+		}
+
+		if result.OverflowInt(intValue) {
+			return result, fmt.Errorf("%w: %d overflows %s", ErrTypeMismatch, intValue, fieldType)
+		}
+
+		result.SetInt(intValue)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		intValue, ok := utils.ExtractIntValue(cv)
+		if !ok {
+			return result, mismatch()
+		}
+
+		if intValue < 0 || result.OverflowUint(uint64(intValue)) {
+			return result, fmt.Errorf("%w: %d overflows %s", ErrTypeMismatch, intValue, fieldType)
+		}
+
+		result.SetUint(uint64(intValue))
+	case reflect.Float32, reflect.Float64:
+		floatValue, ok := utils.ExtractFloatValue(cv)
+		if !ok {
+			intValue, isInt := utils.ExtractIntValue(cv)
+			if !isInt {
+				return result, mismatch()
+			}
+
+			floatValue = float64(intValue)
+		}
+
+		result.SetFloat(floatValue)
+	case reflect.Bool:
+		boolValue, ok := utils.ExtractBoolValue(cv)
+		if !ok {
+			return result, mismatch()
+		}
+
+		result.SetBool(boolValue)
+	case reflect.String:
+		stringValue, ok := utils.ExtractStringValue(cv)
+		if !ok {
+			return result, mismatch()
+		}
+
+		result.SetString(stringValue)
+	default:
+		if fieldType.Kind() == reflect.Slice && fieldType.Elem().Kind() == reflect.String {
+			if values, ok := utils.ExtractStringListValue(cv); ok {
+				result.Set(reflect.ValueOf(values).Convert(fieldType))
+
+				return result, nil
+			}
+		}
+
+		jsonString, ok := utils.ExtractJSONStringValue(cv)
+		if !ok {
+			return result, mismatch()
+		}
+
+		decoded := reflect.New(fieldType)
+		if err := json.Unmarshal([]byte(jsonString), decoded.Interface()); err != nil {
+			return result, fmt.Errorf("decoding JSON into %s: %w", fieldType, err)
+		}
+
+		result.Set(decoded.Elem())
+	}
+
+	return result, nil
+}
+
+// valueFromDefault parses a `default` tag. String slices are comma separated,
+// durations use time.ParseDuration and struct, map and other slice fields take JSON.
+func valueFromDefault(defaultValue string, fieldType reflect.Type) (reflect.Value, error) {
+	result := reflect.New(fieldType).Elem()
+
+	if fieldType == durationType {
+		duration, err := time.ParseDuration(defaultValue)
+		if err != nil {
+			return result, err
+		}
+
+		result.SetInt(int64(duration))
+
+		return result, nil
+	}
+
+	switch fieldType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		intValue, err := strconv.ParseInt(defaultValue, 10, fieldType.Bits())
+		if err != nil {
+			return result, err
+		}
+
+		result.SetInt(intValue)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		uintValue, err := strconv.ParseUint(defaultValue, 10, fieldType.Bits())
+		if err != nil {
+			return result, err
+		}
+
+		result.SetUint(uintValue)
+	case reflect.Float32, reflect.Float64:
+		floatValue, err := strconv.ParseFloat(defaultValue, fieldType.Bits())
+		if err != nil {
+			return result, err
+		}
+
+		result.SetFloat(floatValue)
+	case reflect.Bool:
+		boolValue, err := strconv.ParseBool(defaultValue)
+		if err != nil {
+			return result, err
+		}
+
+		result.SetBool(boolValue)
+	case reflect.String:
+		result.SetString(defaultValue)
+	default:
+		if fieldType.Kind() == reflect.Slice && fieldType.Elem().Kind() == reflect.String {
+			var values []string
+			if defaultValue != "" {
+				values = strings.Split(defaultValue, ",")
+			}
+
+			result.Set(reflect.ValueOf(values).Convert(fieldType))
+
+			return result, nil
+		}
+
+		decoded := reflect.New(fieldType)
+		if err := json.Unmarshal([]byte(defaultValue), decoded.Interface()); err != nil {
+			return result, err
+		}
+
+		result.Set(decoded.Elem())
+	}
+
+	return result, nil
+}
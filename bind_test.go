@@ -0,0 +1,167 @@
+package reforge
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ReforgeHQ/sdk-go/internal/options"
+	"github.com/ReforgeHQ/sdk-go/internal/stores"
+	"github.com/ReforgeHQ/sdk-go/internal/utils"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+type paymentsLimits struct {
+	Daily int `json:"daily"`
+}
+
+type paymentsConfig struct {
+	Name       string
+	Providers  []string       `reforge:"providers" default:"stripe,adyen"`
+	Limits     paymentsLimits `reforge:"limits"`
+	Timeout    time.Duration  `reforge:"timeout" default:"5s"`
+	MaxRetries int            `reforge:"retry.max" default:"3"`
+	Rate       float64        `reforge:"rate"`
+	Enabled    bool           `reforge:"enabled"`
+}
+
+func staticConfig(t *testing.T, key string, value any) *prefabProto.Config {
+	t.Helper()
+
+	configValue, ok := utils.Create(value)
+	require.True(t, ok)
+
+	return &prefabProto.Config{
+		Key:  key,
+		Rows: []*prefabProto.ConfigRow{{Values: []*prefabProto.ConditionalValue{{Value: configValue}}}},
+	}
+}
+
+func TestBind(t *testing.T) {
+	store := &mutableTestStore{configs: map[string]*prefabProto.Config{}}
+	store.set(staticConfig(t, "payments.enabled", true))
+	store.set(staticConfig(t, "payments.rate", 0.25))
+	store.set(staticConfig(t, "payments.limits", map[string]interface{}{"daily": 100}))
+	store.set(staticConfig(t, "payments.retry.max", int64(7)))
+
+	client, err := NewSdk(WithCustomStore(store), WithOfflineSources([]string{}), WithAllTelemetryDisabled())
+	require.NoError(t, err)
+
+	target := &paymentsConfig{Name: "untagged"}
+
+	binding, err := Bind(client, "payments.", target)
+	require.NoError(t, err)
+	require.NoError(t, binding.Err())
+
+	assert.Same(t, target, binding.Load())
+	assert.Equal(t, paymentsConfig{
+		Name:       "untagged",
+		Providers:  []string{"stripe", "adyen"},
+		Limits:     paymentsLimits{Daily: 100},
+		Timeout:    5 * time.Second,
+		MaxRetries: 7,
+		Rate:       0.25,
+		Enabled:    true,
+	}, *target)
+
+	t.Run("updates publish a new value", func(t *testing.T) {
+		client.changeListeners.dispatch([]stores.ConfigChange{store.set(staticConfig(t, "payments.retry.max", int64(9)))})
+
+		updated := binding.Load()
+		assert.NotSame(t, target, updated)
+		assert.Equal(t, 9, updated.MaxRetries)
+		assert.Equal(t, 7, target.MaxRetries, "a published value must not be modified")
+		require.NoError(t, binding.Err())
+	})
+
+	t.Run("problems are aggregated and fields keep their last value", func(t *testing.T) {
+		client.changeListeners.dispatch([]stores.ConfigChange{
+			store.set(staticConfig(t, "payments.enabled", "yes")),
+			store.set(staticConfig(t, "payments.rate", "high")),
+		})
+
+		updated := binding.Load()
+		assert.True(t, updated.Enabled)
+		assert.InDelta(t, 0.25, updated.Rate, 0)
+
+		err := binding.Err()
+		require.ErrorIs(t, err, ErrTypeMismatch)
+		assert.Contains(t, err.Error(), `field Enabled (key "payments.enabled")`)
+		assert.Contains(t, err.Error(), `field Rate (key "payments.rate")`)
+	})
+
+	t.Run("changes outside the prefix are ignored", func(t *testing.T) {
+		before := binding.Load()
+
+		client.changeListeners.dispatch([]stores.ConfigChange{store.set(staticConfig(t, "other.key", int64(1)))})
+		assert.Same(t, before, binding.Load())
+	})
+
+	t.Run("closed bindings stop updating", func(t *testing.T) {
+		binding.Close()
+		before := binding.Load()
+
+		client.changeListeners.dispatch([]stores.ConfigChange{store.set(staticConfig(t, "payments.retry.max", int64(11)))})
+		assert.Same(t, before, binding.Load())
+	})
+}
+
+func TestBindReportsMissingKeys(t *testing.T) {
+	store := &mutableTestStore{configs: map[string]*prefabProto.Config{}}
+	store.set(staticConfig(t, "payments.enabled", true))
+
+	client, err := NewSdk(WithCustomStore(store), WithOfflineSources([]string{}), WithAllTelemetryDisabled())
+	require.NoError(t, err)
+
+	binding, err := Bind(client, "payments.", &paymentsConfig{})
+	require.ErrorIs(t, err, ErrKeyNotFound)
+	assert.Contains(t, err.Error(), `field Limits (key "payments.limits")`)
+	assert.Contains(t, err.Error(), `field Rate (key "payments.rate")`)
+	assert.NotContains(t, err.Error(), "payments.retry.max")
+
+	require.NotNil(t, binding)
+	assert.True(t, binding.Load().Enabled)
+	assert.Equal(t, 3, binding.Load().MaxRetries)
+}
+
+func TestBindRejectsInvalidTargets(t *testing.T) {
+	client, err := NewSdk(WithOfflineSources([]string{}), WithAllTelemetryDisabled())
+	require.NoError(t, err)
+
+	_, err = Bind[paymentsConfig](client, "payments.", nil)
+	require.Error(t, err)
+
+	number := 1
+	_, err = Bind(client, "payments.", &number)
+	require.ErrorContains(t, err, "must point to a struct")
+
+	_, err = Bind(client, "payments.", &struct {
+		hidden int `reforge:"hidden"`
+	}{})
+	require.ErrorContains(t, err, "not exported")
+}
+
+func TestBindHonorsOnInitializationFailure(t *testing.T) {
+	type testConfig struct {
+		Key string `reforge:"key"`
+	}
+
+	t.Run("ReturnError reports the timeout", func(t *testing.T) {
+		client := newNeverInitClient(t, 0.05, options.ReturnError)
+
+		binding, err := Bind(client, "test.", &testConfig{})
+		require.ErrorIs(t, err, ErrInitTimeout)
+		require.NotNil(t, binding)
+		assert.Equal(t, "value", binding.Load().Key)
+	})
+
+	t.Run("ReturnNilMatch carries on", func(t *testing.T) {
+		client := newNeverInitClient(t, 0.05, options.ReturnNilMatch)
+
+		binding, err := Bind(client, "test.", &testConfig{})
+		require.NoError(t, err)
+		assert.Equal(t, "value", binding.Load().Key)
+	})
+}
@@ -0,0 +1,139 @@
+package reforge
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/ReforgeHQ/sdk-go/internal/utils"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+// BootstrapPayload is the server-side evaluation of the configs a client-side
+// SDK may see, ready to hand to a browser so flags are known on first paint.
+type BootstrapPayload struct {
+	Evaluations *prefabProto.ConfigEvaluations
+	// JSON is Evaluations in protojson form with <, > and & escaped, so it can
+	// be written inside a <script> tag as is.
+	JSON []byte
+}
+
+// SendToClientSdkFilter selects feature flags and the configs marked to be
+// sent to client-side SDKs.
+func SendToClientSdkFilter(config *prefabProto.Config) bool {
+	return config.GetConfigType() == prefabProto.ConfigType_FEATURE_FLAG || config.GetSendToClientSdk()
+}
+
+// BootstrapPayload evaluates the client-visible configs for contextSet. See ContextBoundClient.BootstrapPayload.
+func (c *Client) BootstrapPayload(contextSet ContextSet) (*BootstrapPayload, error) {
+	return c.boundClient.WithContext(&contextSet).BootstrapPayload()
+}
+
+// BootstrapPayload evaluates every config selected by SendToClientSdkFilter
+// for the bound context and returns the results as ConfigEvaluations.
+//
+// Confidential and decrypted values are left out, as are configs that failed
+// to evaluate or whose value type client SDKs can't represent.
+func (c *ContextBoundClient) BootstrapPayload() (*BootstrapPayload, error) {
+	results, err := c.EvaluateAll(SendToClientSdkFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	evaluations := &prefabProto.ConfigEvaluations{Values: make(map[string]*prefabProto.ClientConfigValue, len(results))}
+
+	for key, result := range results {
+		if !result.Found || isSecret(result.Match) {
+			continue
+		}
+
+		value, ok := clientConfigValue(result.Match.Match)
+		if !ok {
+			continue
+		}
+
+		value.ConfigEvaluationMetadata = evaluationMetadata(result.Match)
+		evaluations.Values[key] = value
+	}
+
+	encoded, err := protojson.Marshal(evaluations)
+	if err != nil {
+		return nil, err
+	}
+
+	var escaped bytes.Buffer
+	json.HTMLEscape(&escaped, encoded)
+
+	return &BootstrapPayload{Evaluations: evaluations, JSON: escaped.Bytes()}, nil
+}
+
+func isSecret(match ConfigMatch) bool {
+	return match.Match.GetConfidential() ||
+		match.OriginalMatch.GetConfidential() ||
+		match.OriginalMatch.GetDecryptWith() != ""
+}
+
+// clientConfigValue converts cv to its client SDK form. Bytes, limits and
+// schemas have no client form.
+func clientConfigValue(cv *prefabProto.ConfigValue) (*prefabProto.ClientConfigValue, bool) {
+	value := &prefabProto.ClientConfigValue{}
+
+	switch typed := cv.GetType().(type) {
+	case *prefabProto.ConfigValue_Int:
+		value.Type = &prefabProto.ClientConfigValue_Int{Int: typed.Int}
+	case *prefabProto.ConfigValue_String_:
+		value.Type = &prefabProto.ClientConfigValue_String_{String_: typed.String_}
+	case *prefabProto.ConfigValue_Double:
+		value.Type = &prefabProto.ClientConfigValue_Double{Double: typed.Double}
+	case *prefabProto.ConfigValue_Bool:
+		value.Type = &prefabProto.ClientConfigValue_Bool{Bool: typed.Bool}
+	case *prefabProto.ConfigValue_LogLevel:
+		value.Type = &prefabProto.ClientConfigValue_LogLevel{LogLevel: typed.LogLevel}
+	case *prefabProto.ConfigValue_StringList:
+		value.Type = &prefabProto.ClientConfigValue_StringList{StringList: typed.StringList}
+	case *prefabProto.ConfigValue_IntRange:
+		value.Type = &prefabProto.ClientConfigValue_IntRange{IntRange: typed.IntRange}
+	case *prefabProto.ConfigValue_Json:
+		value.Type = &prefabProto.ClientConfigValue_Json{Json: typed.Json}
+	case *prefabProto.ConfigValue_Duration:
+		duration, ok := utils.ExtractDurationValue(cv)
+		if !ok {
+			return nil, false
+		}
+
+		value.Type = &prefabProto.ClientConfigValue_Duration{Duration: &prefabProto.ClientDuration{
+			Seconds:    int64(duration / time.Second),
+			Nanos:      int32(duration % time.Second),
+			Definition: typed.Duration.GetDefinition(),
+		}}
+	default:
+		return nil, false
+	}
+
+	return value, true
+}
+
+func evaluationMetadata(match ConfigMatch) *prefabProto.ConfigEvaluationMetaData {
+	valueType := utils.GetValueType(match.Match)
+
+	return &prefabProto.ConfigEvaluationMetaData{
+		ConfigRowIndex:        intPtrToInt64Ptr(match.RowIndex),
+		ConditionalValueIndex: intPtrToInt64Ptr(match.ConditionalValueIndex),
+		WeightedValueIndex:    intPtrToInt64Ptr(match.WeightedValueIndex),
+		Type:                  &match.ConfigType,
+		Id:                    &match.ConfigID,
+		ValueType:             &valueType,
+	}
+}
+
+func intPtrToInt64Ptr(value *int) *int64 {
+	if value == nil {
+		return nil
+	}
+
+	converted := int64(*value)
+
+	return &converted
+}
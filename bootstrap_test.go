@@ -0,0 +1,75 @@
+package reforge
+
+import (
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/ReforgeHQ/sdk-go/internal"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+func TestBootstrapPayload(t *testing.T) {
+	clientConfig := func(key string, value any) *prefabProto.Config {
+		config := staticConfig(t, key, value)
+		config.SendToClientSdk = true
+
+		return config
+	}
+
+	flag := staticConfig(t, "flags.checkout", true)
+	flag.ConfigType = prefabProto.ConfigType_FEATURE_FLAG
+	flag.Id = 42
+
+	confidential := clientConfig("client.confidential", "hidden")
+	confidential.Rows[0].Values[0].Value.Confidential = internal.BoolPtr(true)
+
+	// #nosec G101 -- this is a test key
+	encrypted := clientConfig("client.encrypted", "b837acfdedb9f6286947fb95f6fb--13490148d8d3ddf0decc3d14--add9b0ed6de775080bec4c5b6025d67e")
+	encrypted.Rows[0].Values[0].Value.DecryptWith = stringPtr("secret.key")
+
+	store := &mutableTestStore{configs: map[string]*prefabProto.Config{}}
+	store.set(flag)
+	store.set(clientConfig("client.banner", "</script><script>alert(1)</script>"))
+	store.set(clientConfig("client.timeout", 1500*time.Millisecond))
+	store.set(staticConfig(t, "server.only", "not for browsers"))
+	store.set(staticConfig(t, "secret.key", "e657e0406fc22e17d3145966396b2130d33dcb30ac0edd62a77235cdd01fc49d"))
+	store.set(confidential)
+	store.set(encrypted)
+
+	client, err := NewSdk(WithCustomStore(store), WithOfflineSources([]string{}), WithAllTelemetryDisabled())
+	require.NoError(t, err)
+
+	decrypted, _, err := client.GetStringValue("client.encrypted", *NewContextSet())
+	require.NoError(t, err)
+	require.Equal(t, "james-was-here", decrypted)
+
+	payload, err := client.BootstrapPayload(*NewContextSet())
+	require.NoError(t, err)
+
+	values := payload.Evaluations.GetValues()
+	assert.ElementsMatch(t, []string{"flags.checkout", "client.banner", "client.timeout"}, slices.Collect(maps.Keys(values)))
+
+	assert.True(t, values["flags.checkout"].GetBool())
+	assert.Equal(t, int64(42), values["flags.checkout"].GetConfigEvaluationMetadata().GetId())
+	assert.Equal(t, prefabProto.ConfigType_FEATURE_FLAG, values["flags.checkout"].GetConfigEvaluationMetadata().GetType())
+	assert.Equal(t, prefabProto.Config_BOOL, values["flags.checkout"].GetConfigEvaluationMetadata().GetValueType())
+	assert.Equal(t, int64(0), values["flags.checkout"].GetConfigEvaluationMetadata().GetConfigRowIndex())
+
+	assert.Equal(t, int64(1), values["client.timeout"].GetDuration().GetSeconds())
+	assert.Equal(t, int32(500_000_000), values["client.timeout"].GetDuration().GetNanos())
+
+	t.Run("JSON is safe to embed in a script tag", func(t *testing.T) {
+		assert.NotContains(t, string(payload.JSON), "<")
+		assert.NotContains(t, string(payload.JSON), ">")
+
+		var decoded prefabProto.ConfigEvaluations
+		require.NoError(t, protojson.Unmarshal(payload.JSON, &decoded))
+		assert.Equal(t, "</script><script>alert(1)</script>", decoded.GetValues()["client.banner"].GetString_())
+	})
+}
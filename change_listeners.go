@@ -0,0 +1,117 @@
+package reforge
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+
+	"github.com/ReforgeHQ/sdk-go/internal/stores"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+// ChangeEvent describes a config that changed in the store after an update
+// from the API (the initial load or an SSE batch).
+type ChangeEvent struct {
+	// OldConfig is the config the store held before the change, or nil if the key is new.
+	OldConfig *prefabProto.Config
+	// NewConfig is the config that was applied. For a tombstone it has no rows.
+	NewConfig *prefabProto.Config
+	// ChangedBy identifies who made the change, when the API reports it.
+	ChangedBy *prefabProto.ChangedBy
+	Key       string
+	ConfigID  int64
+	// Tombstone is true when the update carried no rows and removed the key from the store.
+	Tombstone bool
+	// Deleted is true when the new config has ConfigType_DELETED.
+	Deleted bool
+}
+
+func newChangeEvent(change stores.ConfigChange) ChangeEvent {
+	return ChangeEvent{
+		OldConfig: change.Old,
+		NewConfig: change.New,
+		ChangedBy: change.New.GetChangedBy(),
+		Key:       change.Key,
+		ConfigID:  change.New.GetId(),
+		Tombstone: len(change.New.GetRows()) == 0,
+		Deleted:   change.New.GetConfigType() == prefabProto.ConfigType_DELETED,
+	}
+}
+
+type changeListener struct {
+	callback func(ChangeEvent)
+	prefix   string
+}
+
+// changeListeners holds the callbacks registered with OnChange/OnAnyChange
+// and dispatches store changes to them.
+type changeListeners struct {
+	listeners map[int]changeListener
+	nextID    int
+	mutex     sync.RWMutex
+}
+
+func newChangeListeners() *changeListeners {
+	return &changeListeners{listeners: make(map[int]changeListener)}
+}
+
+func (cl *changeListeners) add(prefix string, callback func(ChangeEvent)) func() {
+	cl.mutex.Lock()
+	defer cl.mutex.Unlock()
+
+	id := cl.nextID
+	cl.nextID++
+	cl.listeners[id] = changeListener{prefix: prefix, callback: callback}
+
+	return func() {
+		cl.mutex.Lock()
+		defer cl.mutex.Unlock()
+
+		delete(cl.listeners, id)
+	}
+}
+
+func (cl *changeListeners) dispatch(changes []stores.ConfigChange) {
+	cl.mutex.RLock()
+	listeners := make([]changeListener, 0, len(cl.listeners))
+
+	for _, listener := range cl.listeners {
+		listeners = append(listeners, listener)
+	}
+	cl.mutex.RUnlock()
+
+	for _, change := range changes {
+		event := newChangeEvent(change)
+
+		for _, listener := range listeners {
+			if strings.HasPrefix(event.Key, listener.prefix) {
+				notifyListener(listener.callback, event)
+			}
+		}
+	}
+}
+
+func notifyListener(callback func(ChangeEvent), event ChangeEvent) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error(fmt.Sprintf("change listener for key %s panicked: %v", event.Key, r))
+		}
+	}()
+
+	callback(event)
+}
+
+// OnChange registers a callback that is invoked after the store commits a
+// change to any key starting with keyOrPrefix (pass a full key to watch just
+// that key). Callbacks run synchronously on the goroutine applying the update,
+// so they should return quickly. The returned func unregisters the callback.
+func (c *Client) OnChange(keyOrPrefix string, callback func(ChangeEvent)) func() {
+	return c.changeListeners.add(keyOrPrefix, callback)
+}
+
+// OnAnyChange registers a callback that is invoked for every committed config
+// change. See OnChange.
+func (c *Client) OnAnyChange(callback func(ChangeEvent)) func() {
+	return c.changeListeners.add("", callback)
+}
@@ -0,0 +1,63 @@
+package reforge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ReforgeHQ/sdk-go/internal/stores"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+func TestChangeListeners(t *testing.T) {
+	client, err := NewSdk(WithConfigs(map[string]interface{}{}), WithAllTelemetryDisabled())
+	require.NoError(t, err)
+
+	changedBy := &prefabProto.ChangedBy{Email: "someone@example.com"}
+	poolSize := &prefabProto.Config{Id: 2, Key: "db.pool.size", ChangedBy: changedBy, Rows: []*prefabProto.ConfigRow{{}}}
+	poolSizeOld := &prefabProto.Config{Id: 1, Key: "db.pool.size", Rows: []*prefabProto.ConfigRow{{}}}
+	flagTombstone := &prefabProto.Config{Id: 3, Key: "flag.removed"}
+	deleted := &prefabProto.Config{Id: 4, Key: "db.old", ConfigType: prefabProto.ConfigType_DELETED}
+
+	var prefixEvents, anyEvents []ChangeEvent
+
+	client.OnChange("db.", func(event ChangeEvent) {
+		prefixEvents = append(prefixEvents, event)
+	})
+
+	unsubscribe := client.OnAnyChange(func(event ChangeEvent) {
+		anyEvents = append(anyEvents, event)
+	})
+
+	client.OnChange("db.pool.size", func(ChangeEvent) {
+		panic("listener panics are contained")
+	})
+
+	client.changeListeners.dispatch([]stores.ConfigChange{
+		{Key: "db.pool.size", Old: poolSizeOld, New: poolSize},
+		{Key: "flag.removed", New: flagTombstone},
+		{Key: "db.old", New: deleted},
+	})
+
+	require.Len(t, prefixEvents, 2)
+	assert.Equal(t, ChangeEvent{
+		OldConfig: poolSizeOld,
+		NewConfig: poolSize,
+		ChangedBy: changedBy,
+		Key:       "db.pool.size",
+		ConfigID:  2,
+	}, prefixEvents[0])
+	assert.Equal(t, "db.old", prefixEvents[1].Key)
+	assert.True(t, prefixEvents[1].Deleted)
+	assert.True(t, prefixEvents[1].Tombstone)
+
+	require.Len(t, anyEvents, 3)
+	assert.Equal(t, "flag.removed", anyEvents[1].Key)
+	assert.True(t, anyEvents[1].Tombstone)
+	assert.False(t, anyEvents[1].Deleted)
+
+	unsubscribe()
+	client.changeListeners.dispatch([]stores.ConfigChange{{Key: "flag.other", New: poolSize}})
+	assert.Len(t, anyEvents, 3)
+}
@@ -0,0 +1,128 @@
+package reforge_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	reforge "github.com/ReforgeHQ/sdk-go"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+func TestCloseMakesEvaluationsReturnErrClientClosed(t *testing.T) {
+	client, err := reforge.NewSdk(
+		reforge.WithConfigs(map[string]interface{}{"string.key": "value"}),
+		reforge.WithAllTelemetryDisabled(),
+	)
+	require.NoError(t, err)
+
+	str, ok, err := client.GetStringValue("string.key", *reforge.NewContextSet())
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "value", str)
+
+	require.NoError(t, client.Close(context.Background()))
+
+	_, ok, err = client.GetStringValue("string.key", *reforge.NewContextSet())
+	require.ErrorIs(t, err, reforge.ErrClientClosed)
+	assert.False(t, ok)
+
+	_, err = client.GetConfigMatch("string.key", *reforge.NewContextSet())
+	require.ErrorIs(t, err, reforge.ErrClientClosed)
+
+	_, err = client.Keys()
+	require.ErrorIs(t, err, reforge.ErrClientClosed)
+
+	value, _ := client.GetStringValueWithDefault("string.key", *reforge.NewContextSet(), "default")
+	assert.Equal(t, "default", value)
+
+	// A second Close is a no-op
+	require.NoError(t, client.Close(context.Background()))
+}
+
+func TestCloseFlushesQueuedTelemetry(t *testing.T) {
+	var (
+		mutex    sync.Mutex
+		payloads []*prefabProto.TelemetryEvents
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		var payload prefabProto.TelemetryEvents
+		assert.NoError(t, proto.Unmarshal(body, &payload))
+
+		mutex.Lock()
+		payloads = append(payloads, &payload)
+		mutex.Unlock()
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := reforge.NewSdk(
+		reforge.WithSdkKey("test-key"),
+		reforge.WithConfigs(map[string]interface{}{"bool.key": true}),
+		reforge.WithTelemetryHost(server.URL),
+		reforge.WithTelemetrySyncInterval(time.Hour),
+	)
+	require.NoError(t, err)
+
+	for range 3 {
+		_, _, err = client.GetBoolValue("bool.key", *reforge.NewContextSet())
+		require.NoError(t, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	require.NoError(t, client.Close(ctx))
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	require.Len(t, payloads, 1)
+	require.Len(t, payloads[0].GetEvents(), 1)
+
+	summaries := payloads[0].GetEvents()[0].GetSummaries().GetSummaries()
+	require.Len(t, summaries, 1)
+	assert.Equal(t, "bool.key", summaries[0].GetKey())
+	assert.Equal(t, int64(3), summaries[0].GetCounters()[0].GetCount())
+}
+
+func TestCloseReleasesCallersWaitingOnInitialization(t *testing.T) {
+	t.Setenv("REFORGE_API_URL_OVERRIDE", "http://127.0.0.1:1")
+
+	client, err := reforge.NewSdk(
+		reforge.WithSdkKey("test-key"),
+		reforge.WithInitializationTimeoutSeconds(30),
+		reforge.WithAllTelemetryDisabled(),
+	)
+	require.NoError(t, err)
+
+	errs := make(chan error, 1)
+
+	go func() {
+		_, _, err := client.GetStringValue("string.key", *reforge.NewContextSet())
+		errs <- err
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	require.NoError(t, client.Close(context.Background()))
+
+	select {
+	case err := <-errs:
+		require.ErrorIs(t, err, reforge.ErrClientClosed)
+	case <-time.After(2 * time.Second):
+		t.Fatal("evaluation was still blocked after Close")
+	}
+}
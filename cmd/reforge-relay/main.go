@@ -0,0 +1,106 @@
+// Command reforge-relay holds one connection to the Reforge API and serves
+// the same config protocol to many SDK instances, so a fleet of pods shares a
+// single download and stream. Point the pods' REFORGE_API_URL at the relay;
+// they must use the same SDK key as the relay.
+//
+// SDKs send telemetry to their telemetry host, not their API URL, so the
+// relay only batches it for pods whose telemetry host is also set to the
+// relay, with reforge.WithTelemetryHost. Pods left on the default send
+// telemetry straight to Reforge.
+//
+// Usage:
+//
+//	REFORGE_BACKEND_SDK_KEY=... reforge-relay -addr :8080
+//
+// Flags:
+//
+//	-addr                listen address (default ":8080")
+//	-api-url             comma separated upstream API URLs (default REFORGE_API_URL or the Reforge API)
+//	-cache-dir           keep a last-known-good snapshot of the configs in this directory
+//	-polling-interval    poll upstream on this interval instead of streaming
+//	-telemetry-host      where SDK telemetry is forwarded (default https://telemetry.reforge.com)
+//	-telemetry-interval  how often SDK telemetry is forwarded; 0 discards it (default 1m)
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/ReforgeHQ/sdk-go/internal/options"
+	"github.com/ReforgeHQ/sdk-go/internal/relay"
+)
+
+const shutdownTimeout = 10 * time.Second
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, "reforge-relay:", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	opts := options.GetDefaultOptions()
+
+	addr := flag.String("addr", ":8080", "listen address")
+	apiURLs := flag.String("api-url", "", "comma separated upstream API URLs")
+	flag.StringVar(&opts.CacheDir, "cache-dir", "", "keep a last-known-good snapshot of the configs in this directory")
+	flag.DurationVar(&opts.PollingInterval, "polling-interval", 0, "poll upstream on this interval instead of streaming")
+	flag.StringVar(&opts.TelemetryHost, "telemetry-host", opts.TelemetryHost, "where SDK telemetry is forwarded")
+	flag.DurationVar(&opts.TelemetrySyncInterval, "telemetry-interval", opts.TelemetrySyncInterval, "how often SDK telemetry is forwarded; 0 discards it")
+	flag.Parse()
+
+	if *apiURLs != "" {
+		opts.APIURLs = strings.Split(*apiURLs, ",")
+	}
+
+	if opts.PollingInterval < 0 || opts.TelemetrySyncInterval < 0 {
+		return errors.New("intervals must not be negative")
+	}
+
+	r, err := relay.New(opts)
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{Addr: *addr, Handler: r.Handler(), ReadHeaderTimeout: 10 * time.Second}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+
+	go func() {
+		slog.Info("reforge-relay listening", "addr", *addr)
+		serveErr <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serveErr:
+		closeCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		_ = r.Close(closeCtx)
+
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	// Ending the streams first lets Shutdown finish; SDKs reconnect elsewhere.
+	// The telemetry flush and Shutdown share the timeout.
+	closeErr := r.Close(shutdownCtx)
+
+	return errors.Join(closeErr, server.Shutdown(shutdownCtx))
+}
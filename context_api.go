@@ -0,0 +1,134 @@
+package reforge
+
+import (
+	"context"
+	"time"
+
+	"github.com/ReforgeHQ/sdk-go/internal/utils"
+)
+
+// The *Ctx methods mirror the plain getters, but stop waiting for
+// initialization as soon as ctx is done instead of blocking for up to
+// InitializationTimeoutSeconds. When that happens the returned error wraps
+// ctx.Err(), so errors.Is(err, context.DeadlineExceeded) tells a spent
+// request budget apart from ErrInitTimeout.
+
+// GetIntValueCtx returns an int value for a given key and context, giving up waiting for initialization when ctx is done
+func (c *Client) GetIntValueCtx(ctx context.Context, key string, contextSet ContextSet) (value int64, ok bool, err error) {
+	return c.boundClient.GetIntValueCtx(ctx, key, contextSet)
+}
+
+// GetBoolValueCtx returns a bool value for a given key and context, giving up waiting for initialization when ctx is done
+func (c *Client) GetBoolValueCtx(ctx context.Context, key string, contextSet ContextSet) (value bool, ok bool, err error) {
+	return c.boundClient.GetBoolValueCtx(ctx, key, contextSet)
+}
+
+// GetStringValueCtx returns a string value for a given key and context, giving up waiting for initialization when ctx is done
+func (c *Client) GetStringValueCtx(ctx context.Context, key string, contextSet ContextSet) (value string, ok bool, err error) {
+	return c.boundClient.GetStringValueCtx(ctx, key, contextSet)
+}
+
+// GetFloatValueCtx returns a float value for a given key and context, giving up waiting for initialization when ctx is done
+func (c *Client) GetFloatValueCtx(ctx context.Context, key string, contextSet ContextSet) (value float64, ok bool, err error) {
+	return c.boundClient.GetFloatValueCtx(ctx, key, contextSet)
+}
+
+// GetStringSliceValueCtx returns a string slice value for a given key and context, giving up waiting for initialization when ctx is done
+func (c *Client) GetStringSliceValueCtx(ctx context.Context, key string, contextSet ContextSet) (value []string, ok bool, err error) {
+	return c.boundClient.GetStringSliceValueCtx(ctx, key, contextSet)
+}
+
+// GetDurationValueCtx returns a duration value for a given key and context, giving up waiting for initialization when ctx is done
+func (c *Client) GetDurationValueCtx(ctx context.Context, key string, contextSet ContextSet) (value time.Duration, ok bool, err error) {
+	return c.boundClient.GetDurationValueCtx(ctx, key, contextSet)
+}
+
+// GetBytesValueCtx returns a bytes value for a given key and context, giving up waiting for initialization when ctx is done
+func (c *Client) GetBytesValueCtx(ctx context.Context, key string, contextSet ContextSet) (value []byte, ok bool, err error) {
+	return c.boundClient.GetBytesValueCtx(ctx, key, contextSet)
+}
+
+// GetIntRangeValueCtx returns an int range value for a given key and context, giving up waiting for initialization when ctx is done
+func (c *Client) GetIntRangeValueCtx(ctx context.Context, key string, contextSet ContextSet) (value IntRange, ok bool, err error) {
+	return c.boundClient.GetIntRangeValueCtx(ctx, key, contextSet)
+}
+
+// GetJSONValueCtx returns a JSON value for a given key and context, giving up waiting for initialization when ctx is done
+func (c *Client) GetJSONValueCtx(ctx context.Context, key string, contextSet ContextSet) (value interface{}, ok bool, err error) {
+	return c.boundClient.GetJSONValueCtx(ctx, key, contextSet)
+}
+
+// GetLogLevelStringValueCtx returns a log level string for a given key and context, giving up waiting for initialization when ctx is done
+func (c *Client) GetLogLevelStringValueCtx(ctx context.Context, key string, contextSet ContextSet) (value string, ok bool, err error) {
+	return c.boundClient.GetLogLevelStringValueCtx(ctx, key, contextSet)
+}
+
+// GetConfigMatchCtx returns a ConfigMatch object for a given key and context, giving up waiting for initialization when ctx is done
+func (c *Client) GetConfigMatchCtx(ctx context.Context, key string, contextSet ContextSet) (*ConfigMatch, error) {
+	return c.boundClient.GetConfigMatchCtx(ctx, key, contextSet)
+}
+
+// WaitForReady blocks until the client's sources have finished their initial load. See Client.WaitForReady.
+func (c *ContextBoundClient) WaitForReady(ctx context.Context) error {
+	return c.client.WaitForReady(ctx)
+}
+
+// GetIntValueCtx returns an int value for a given key and context, giving up waiting for initialization when ctx is done
+func (c *ContextBoundClient) GetIntValueCtx(ctx context.Context, key string, contextSet ContextSet) (value int64, ok bool, err error) {
+	return clientInternalGetValueFunc(ctx, c, key, contextSet, utils.ExtractIntValue)
+}
+
+// GetBoolValueCtx returns a bool value for a given key and context, giving up waiting for initialization when ctx is done
+func (c *ContextBoundClient) GetBoolValueCtx(ctx context.Context, key string, contextSet ContextSet) (value bool, ok bool, err error) {
+	return clientInternalGetValueFunc(ctx, c, key, contextSet, utils.ExtractBoolValue)
+}
+
+// GetStringValueCtx returns a string value for a given key and context, giving up waiting for initialization when ctx is done
+func (c *ContextBoundClient) GetStringValueCtx(ctx context.Context, key string, contextSet ContextSet) (value string, ok bool, err error) {
+	return clientInternalGetValueFunc(ctx, c, key, contextSet, utils.ExtractStringValue)
+}
+
+// GetFloatValueCtx returns a float value for a given key and context, giving up waiting for initialization when ctx is done
+func (c *ContextBoundClient) GetFloatValueCtx(ctx context.Context, key string, contextSet ContextSet) (value float64, ok bool, err error) {
+	return clientInternalGetValueFunc(ctx, c, key, contextSet, utils.ExtractFloatValue)
+}
+
+// GetStringSliceValueCtx returns a string slice value for a given key and context, giving up waiting for initialization when ctx is done
+func (c *ContextBoundClient) GetStringSliceValueCtx(ctx context.Context, key string, contextSet ContextSet) (value []string, ok bool, err error) {
+	return clientInternalGetValueFunc(ctx, c, key, contextSet, utils.ExtractStringListValue)
+}
+
+// GetDurationValueCtx returns a duration value for a given key and context, giving up waiting for initialization when ctx is done
+func (c *ContextBoundClient) GetDurationValueCtx(ctx context.Context, key string, contextSet ContextSet) (value time.Duration, ok bool, err error) {
+	return clientInternalGetValueFunc(ctx, c, key, contextSet, utils.ExtractDurationValue)
+}
+
+// GetBytesValueCtx returns a bytes value for a given key and context, giving up waiting for initialization when ctx is done
+func (c *ContextBoundClient) GetBytesValueCtx(ctx context.Context, key string, contextSet ContextSet) (value []byte, ok bool, err error) {
+	return clientInternalGetValueFunc(ctx, c, key, contextSet, utils.ExtractBytesValue)
+}
+
+// GetIntRangeValueCtx returns an int range value for a given key and context, giving up waiting for initialization when ctx is done
+func (c *ContextBoundClient) GetIntRangeValueCtx(ctx context.Context, key string, contextSet ContextSet) (value IntRange, ok bool, err error) {
+	return clientInternalGetValueFunc(ctx, c, key, contextSet, extractIntRangeValue)
+}
+
+// GetJSONValueCtx returns a JSON value for a given key and context, giving up waiting for initialization when ctx is done
+func (c *ContextBoundClient) GetJSONValueCtx(ctx context.Context, key string, contextSet ContextSet) (value interface{}, ok bool, err error) {
+	return clientInternalGetValueFunc(ctx, c, key, contextSet, utils.ExtractJSONValueWithoutError)
+}
+
+// GetLogLevelStringValueCtx returns a log level string for a given key and context, giving up waiting for initialization when ctx is done
+func (c *ContextBoundClient) GetLogLevelStringValueCtx(ctx context.Context, key string, contextSet ContextSet) (value string, ok bool, err error) {
+	rawValue, ok, err := clientInternalGetValueFunc(ctx, c, key, contextSet, utils.ExtractLogLevelValue)
+	if err != nil || !ok {
+		return "", false, err
+	}
+
+	return rawValue.String(), true, nil
+}
+
+// GetConfigMatchCtx returns a ConfigMatch object for a given key and context, giving up waiting for initialization when ctx is done
+func (c *ContextBoundClient) GetConfigMatchCtx(ctx context.Context, key string, contextSet ContextSet) (*ConfigMatch, error) {
+	return c.getConfigMatch(ctx, key, contextSet)
+}
@@ -0,0 +1,86 @@
+package reforge
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ReforgeHQ/sdk-go/internal/options"
+)
+
+func TestCtxGetterStopsWaitingWhenContextIsDone(t *testing.T) {
+	client := newNeverInitClient(t, 10, options.ReturnError)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, ok, err := client.GetStringValueCtx(ctx, "test.key", *NewContextSet())
+	require.Error(t, err)
+	assert.False(t, ok)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.NotErrorIs(t, err, ErrInitTimeout)
+	assert.Less(t, time.Since(start), time.Second)
+
+	// A spent request budget says nothing about initialization, so the
+	// channel stays open for callers with more patience
+	assert.False(t, channelIsClosed(client.initializationComplete))
+}
+
+func TestCtxGetterReportsInitTimeout(t *testing.T) {
+	client := newNeverInitClient(t, 0.05, options.ReturnError)
+
+	_, _, err := client.GetBoolValueCtx(context.Background(), "test.key", *NewContextSet())
+	require.ErrorIs(t, err, ErrInitTimeout)
+	assert.False(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestCtxGetterReturnsValueOnceInitialized(t *testing.T) {
+	client := newNeverInitClient(t, 10, options.ReturnError)
+	close(client.initializationComplete)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Initialization has completed, so an already-done ctx doesn't prevent evaluation
+	val, ok, err := client.GetStringValueCtx(ctx, "test.key", *NewContextSet())
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "value", val)
+}
+
+func TestWaitForReady(t *testing.T) {
+	t.Run("ready client returns nil", func(t *testing.T) {
+		client := newNeverInitClient(t, 10, options.ReturnError)
+		close(client.initializationComplete)
+
+		require.NoError(t, client.WaitForReady(context.Background()))
+	})
+
+	t.Run("cancelled ctx returns ctx error", func(t *testing.T) {
+		client := newNeverInitClient(t, 10, options.ReturnError)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := client.WaitForReady(ctx)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("init timeout is reported on this and later calls", func(t *testing.T) {
+		client := newNeverInitClient(t, 0.05, options.ReturnNilMatch)
+
+		require.ErrorIs(t, client.WaitForReady(context.Background()), ErrInitTimeout)
+		require.ErrorIs(t, client.WaitForReady(context.Background()), ErrInitTimeout)
+
+		// Evaluations proceed after the timeout in ReturnNilMatch mode
+		val, ok, err := client.GetStringValueCtx(context.Background(), "test.key", *NewContextSet())
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, "value", val)
+	})
+}
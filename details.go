@@ -0,0 +1,215 @@
+package reforge
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ReforgeHQ/sdk-go/internal"
+	"github.com/ReforgeHQ/sdk-go/internal/contexts"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+// EvaluationReason explains why an evaluation produced its value.
+type EvaluationReason int
+
+const (
+	// ReasonStatic means the config has no targeting for this environment; its only value was returned
+	ReasonStatic EvaluationReason = iota + 1
+	// ReasonTargetingMatch means a rule with criteria matched the context
+	ReasonTargetingMatch
+	// ReasonSplit means the value was picked from a weighted rollout
+	ReasonSplit
+	// ReasonDefault means no targeting rule applied and the config's fallback value was returned.
+	// When Found is false nothing matched at all and the caller's own default applies.
+	ReasonDefault
+	// ReasonEnvVarProvided means the value was read from an environment variable
+	ReasonEnvVarProvided
+	// ReasonDecrypted means the value is a secret that was decrypted
+	ReasonDecrypted
+	// ReasonError means the evaluation failed; see EvaluationDetails.Err
+	ReasonError
+)
+
+// String returns the string representation of the EvaluationReason
+func (r EvaluationReason) String() string {
+	switch r {
+	case ReasonStatic:
+		return "STATIC"
+	case ReasonTargetingMatch:
+		return "TARGETING_MATCH"
+	case ReasonSplit:
+		return "SPLIT"
+	case ReasonDefault:
+		return "DEFAULT"
+	case ReasonEnvVarProvided:
+		return "ENV_VAR_PROVIDED"
+	case ReasonDecrypted:
+		return "DECRYPTED"
+	case ReasonError:
+		return "ERROR"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// CriterionResult is the outcome of one criterion evaluated while resolving a config.
+type CriterionResult struct {
+	// ValueToMatch is the value the rule compares against
+	ValueToMatch *prefabProto.ConfigValue
+	// ContextValue is the value of PropertyName seen in the context, or nil if it was absent
+	ContextValue any
+	PropertyName string
+	Operator     prefabProto.Criterion_CriterionOperator
+	// RowIndex and ConditionalValueIndex locate the rule, as in ConfigMatch
+	RowIndex              int
+	ConditionalValueIndex int
+	ContextValueFound     bool
+	Passed                bool
+}
+
+// EvaluationDetails describes the result of an evaluation and how it was reached.
+type EvaluationDetails struct {
+	// Value is the evaluated value as returned by ExtractValue. It is nil when Found is false.
+	Value any
+	// Err is the error that ended the evaluation when Reason is ReasonError.
+	Err error
+	// Match is the raw evaluation result. It is nil if the key does not exist.
+	Match *ConfigMatch
+	// EnvID is the project environment id of the matched row, or nil if the row applies to every environment.
+	EnvID *int64
+	Key   string
+	// Criteria lists every criterion evaluated, in order. Rules are evaluated until one matches, so
+	// criteria of later rules are absent. Criteria inside segments are not listed individually.
+	Criteria []CriterionResult
+	Reason   EvaluationReason
+	Found    bool
+}
+
+// GetDetails evaluates key for contextSet and explains the result. See ContextBoundClient.GetDetails.
+func (c *Client) GetDetails(key string, contextSet ContextSet) (EvaluationDetails, error) {
+	return c.boundClient.GetDetails(key, contextSet)
+}
+
+// GetDetailsCtx is GetDetails, giving up waiting for initialization when ctx is done.
+func (c *Client) GetDetailsCtx(ctx context.Context, key string, contextSet ContextSet) (EvaluationDetails, error) {
+	return c.boundClient.GetDetailsCtx(ctx, key, contextSet)
+}
+
+// GetDetails evaluates key for contextSet merged with the bound context, like
+// GetConfigMatch, and reports why the value was chosen: the reason, the
+// matched row's environment and every criterion checked along the way with
+// the context values it saw. It is meant for answering "why is this flag on
+// for customer X?" and its evaluations are not recorded in telemetry.
+//
+// If the evaluation fails, the error is returned and also set on the details
+// with Reason ReasonError. A missing key returns ErrKeyNotFound.
+func (c *ContextBoundClient) GetDetails(key string, contextSet ContextSet) (EvaluationDetails, error) {
+	return c.GetDetailsCtx(context.Background(), key, contextSet)
+}
+
+// GetDetailsCtx is GetDetails, giving up waiting for initialization when ctx is done.
+func (c *ContextBoundClient) GetDetailsCtx(ctx context.Context, key string, contextSet ContextSet) (EvaluationDetails, error) {
+	if err := c.client.readyForEvaluation(ctx); err != nil {
+		return detailsForError(key, err), err
+	}
+
+	mergedContextSet := contexts.Merge(c.context, &contextSet)
+
+	match, trace, err := c.client.configResolver.ResolveValueWithTrace(key, mergedContextSet)
+	if errors.Is(err, internal.ErrConfigDoesNotExist) {
+		err = ErrKeyNotFound
+	}
+
+	details := EvaluationDetails{
+		Key:      key,
+		Match:    &match,
+		EnvID:    match.EnvId,
+		Criteria: criterionResults(trace),
+	}
+
+	if err != nil {
+		details.Reason = ReasonError
+		details.Err = err
+
+		if errors.Is(err, ErrKeyNotFound) {
+			details.Match = nil
+		}
+
+		return details, err
+	}
+
+	if !match.IsMatch || match.Match == nil {
+		details.Reason = ReasonDefault
+
+		return details, nil
+	}
+
+	value, _, err := ExtractValue(match.Match)
+	if err != nil {
+		details.Reason = ReasonError
+		details.Err = err
+
+		return details, err
+	}
+
+	details.Value = value
+	details.Found = true
+	details.Reason = evaluationReason(match, details.Criteria)
+
+	return details, nil
+}
+
+func detailsForError(key string, err error) EvaluationDetails {
+	return EvaluationDetails{Key: key, Reason: ReasonError, Err: err}
+}
+
+func criterionResults(trace *internal.EvaluationTrace) []CriterionResult {
+	results := make([]CriterionResult, 0, len(trace.Criteria))
+
+	for _, evaluation := range trace.Criteria {
+		results = append(results, CriterionResult{
+			ValueToMatch:          evaluation.Criterion.GetValueToMatch(),
+			ContextValue:          evaluation.ContextValue,
+			PropertyName:          evaluation.Criterion.GetPropertyName(),
+			Operator:              evaluation.Criterion.GetOperator(),
+			RowIndex:              evaluation.RowIndex,
+			ConditionalValueIndex: evaluation.ConditionalValueIndex,
+			ContextValueFound:     evaluation.ContextValueExists,
+			Passed:                evaluation.Matched,
+		})
+	}
+
+	return results
+}
+
+func evaluationReason(match ConfigMatch, criteria []CriterionResult) EvaluationReason {
+	switch {
+	case match.OriginalMatch.GetProvided() != nil:
+		return ReasonEnvVarProvided
+	case match.OriginalMatch.GetDecryptWith() != "":
+		return ReasonDecrypted
+	case match.WeightedValueIndex != nil:
+		return ReasonSplit
+	}
+
+	fellThrough := false
+
+	for _, criterion := range criteria {
+		if !criterion.Passed {
+			fellThrough = true
+
+			continue
+		}
+
+		matchedRule := criterion.RowIndex == *match.RowIndex && criterion.ConditionalValueIndex == *match.ConditionalValueIndex
+		if matchedRule && criterion.Operator != prefabProto.Criterion_ALWAYS_TRUE {
+			return ReasonTargetingMatch
+		}
+	}
+
+	if fellThrough {
+		return ReasonDefault
+	}
+
+	return ReasonStatic
+}
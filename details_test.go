@@ -0,0 +1,110 @@
+package reforge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+func TestGetDetails(t *testing.T) {
+	envID := int64(0) // mutableTestStore reports project env id 0
+	providedConfig := staticConfig(t, "db.password", "unused")
+	providedConfig.ValueType = prefabProto.Config_STRING
+	providedConfig.Rows[0].ProjectEnvId = &envID
+	providedConfig.Rows[0].Values[0].Value = &prefabProto.ConfigValue{Type: &prefabProto.ConfigValue_Provided{
+		Provided: &prefabProto.Provided{Source: prefabProto.ProvidedSource_ENV_VAR.Enum(), Lookup: stringPtr("DETAILS_TEST_PASSWORD")},
+	}}
+
+	splitConfig := staticConfig(t, "checkout.variant", "unused")
+	splitConfig.Rows[0].Values[0].Value = &prefabProto.ConfigValue{Type: &prefabProto.ConfigValue_WeightedValues{
+		WeightedValues: &prefabProto.WeightedValues{WeightedValues: []*prefabProto.WeightedValue{
+			{Weight: 1, Value: &prefabProto.ConfigValue{Type: &prefabProto.ConfigValue_String_{String_: "b"}}},
+		}},
+	}}
+
+	store := &mutableTestStore{configs: map[string]*prefabProto.Config{}}
+	store.set(tieredConfig(t, 1, 500, 100))
+	store.set(staticConfig(t, "banner.text", "hello"))
+	store.set(providedConfig)
+	store.set(splitConfig)
+
+	t.Setenv("DETAILS_TEST_PASSWORD", "hunter2")
+
+	client, err := NewSdk(WithCustomStore(store), WithOfflineSources([]string{}), WithAllTelemetryDisabled())
+	require.NoError(t, err)
+
+	gold := *NewContextSet().WithNamedContextValues("user", map[string]interface{}{"tier": "gold"})
+	silver := *NewContextSet().WithNamedContextValues("user", map[string]interface{}{"tier": "silver"})
+
+	t.Run("targeting match", func(t *testing.T) {
+		details, err := client.GetDetails("kafka.batch.size", gold)
+		require.NoError(t, err)
+
+		assert.Equal(t, ReasonTargetingMatch, details.Reason)
+		assert.True(t, details.Found)
+		assert.Equal(t, int64(500), details.Value)
+		require.Len(t, details.Criteria, 1)
+		assert.Equal(t, "user.tier", details.Criteria[0].PropertyName)
+		assert.Equal(t, prefabProto.Criterion_PROP_IS_ONE_OF, details.Criteria[0].Operator)
+		assert.Equal(t, "gold", details.Criteria[0].ContextValue)
+		assert.True(t, details.Criteria[0].ContextValueFound)
+		assert.True(t, details.Criteria[0].Passed)
+	})
+
+	t.Run("falling through to the default", func(t *testing.T) {
+		details, err := client.GetDetails("kafka.batch.size", silver)
+		require.NoError(t, err)
+
+		assert.Equal(t, ReasonDefault, details.Reason)
+		assert.Equal(t, int64(100), details.Value)
+		require.Len(t, details.Criteria, 1)
+		assert.Equal(t, "silver", details.Criteria[0].ContextValue)
+		assert.False(t, details.Criteria[0].Passed)
+	})
+
+	t.Run("static", func(t *testing.T) {
+		details, err := client.GetDetails("banner.text", silver)
+		require.NoError(t, err)
+
+		assert.Equal(t, ReasonStatic, details.Reason)
+		assert.Equal(t, "hello", details.Value)
+		assert.Empty(t, details.Criteria)
+		assert.Nil(t, details.EnvID)
+	})
+
+	t.Run("env var provided", func(t *testing.T) {
+		details, err := client.GetDetails("db.password", silver)
+		require.NoError(t, err)
+
+		assert.Equal(t, ReasonEnvVarProvided, details.Reason)
+		assert.Equal(t, "hunter2", details.Value)
+		require.NotNil(t, details.EnvID)
+		assert.Equal(t, envID, *details.EnvID)
+	})
+
+	t.Run("split", func(t *testing.T) {
+		details, err := client.GetDetails("checkout.variant", silver)
+		require.NoError(t, err)
+
+		assert.Equal(t, ReasonSplit, details.Reason)
+		assert.Equal(t, "b", details.Value)
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		details, err := client.GetDetails("does.not.exist", silver)
+		require.ErrorIs(t, err, ErrKeyNotFound)
+
+		assert.Equal(t, ReasonError, details.Reason)
+		require.ErrorIs(t, details.Err, ErrKeyNotFound)
+		assert.False(t, details.Found)
+		assert.Nil(t, details.Match)
+		assert.Equal(t, "ERROR", details.Reason.String())
+	})
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,24 @@
+package reforge
+
+import (
+	"errors"
+
+	"github.com/ReforgeHQ/sdk-go/internal"
+)
+
+var (
+	// ErrDecryption is returned when a secret could not be decrypted.
+	ErrDecryption = internal.ErrDecryption
+	// ErrClientClosed is returned by evaluations made after Client.Close has been called.
+	ErrClientClosed = errors.New("client closed")
+	// ErrInitTimeout is returned when InitializationTimeoutSeconds elapses before the sources finish loading
+	// and OnInitializationFailure is ReturnError.
+	ErrInitTimeout = errors.New("initialization timeout")
+	// ErrKeyNotFound is returned when a key does not exist in any config store, or, with
+	// WithStrictEvaluation, when no rule produced a value for the context.
+	ErrKeyNotFound = errors.New("key not found")
+	// ErrExceedsBurst is returned by Limiter.WaitN when more tokens are requested than the limit's burst.
+	ErrExceedsBurst = errors.New("request exceeds the limit's burst")
+	// ErrTypeMismatch is returned when a config holds a different type of value than the one requested.
+	ErrTypeMismatch = errors.New("config value type mismatch")
+)
@@ -0,0 +1,113 @@
+package reforge
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ReforgeHQ/sdk-go/internal"
+	"github.com/ReforgeHQ/sdk-go/internal/contexts"
+	"github.com/ReforgeHQ/sdk-go/internal/stores"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+// EvaluationFilter selects the configs EvaluateAll evaluates. A nil filter selects every config.
+type EvaluationFilter func(config *prefabProto.Config) bool
+
+// KeyPrefixFilter selects configs whose key starts with prefix.
+func KeyPrefixFilter(prefix string) EvaluationFilter {
+	return func(config *prefabProto.Config) bool {
+		return strings.HasPrefix(config.GetKey(), prefix)
+	}
+}
+
+// ConfigTypeFilter selects configs of the given types, e.g. prefabProto.ConfigType_FEATURE_FLAG.
+func ConfigTypeFilter(configTypes ...prefabProto.ConfigType) EvaluationFilter {
+	return func(config *prefabProto.Config) bool {
+		for _, configType := range configTypes {
+			if config.GetConfigType() == configType {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// EvaluationResult is one key's result from EvaluateAll.
+type EvaluationResult struct {
+	// Value is the evaluated value as returned by ExtractValue. It is nil when Found is false.
+	Value any
+	// Err is the error that ended this key's evaluation, if any.
+	Err error
+	// Match is the raw evaluation result, with the config's id and type and the matched row.
+	Match ConfigMatch
+	Key   string
+	Found bool
+}
+
+// EvaluateAll evaluates every config selected by filter for contextSet. See ContextBoundClient.EvaluateAll.
+func (c *Client) EvaluateAll(contextSet ContextSet, filter EvaluationFilter) (map[string]EvaluationResult, error) {
+	return c.boundClient.evaluateAll(context.Background(), contexts.Merge(c.boundClient.context, &contextSet), filter)
+}
+
+// EvaluateAll evaluates every config selected by filter for the bound
+// context and returns the results by key. It is meant for pages that show
+// many flags at once: the context is merged once, all evaluations see the
+// same snapshot of the configs, and telemetry is recorded in one batch.
+//
+// Configs that fail to evaluate are returned with Err set rather than
+// failing the whole call; the error is only for the client not being ready.
+func (c *ContextBoundClient) EvaluateAll(filter EvaluationFilter) (map[string]EvaluationResult, error) {
+	return c.EvaluateAllCtx(context.Background(), filter)
+}
+
+// EvaluateAllCtx is EvaluateAll, giving up waiting for initialization when ctx is done.
+func (c *ContextBoundClient) EvaluateAllCtx(ctx context.Context, filter EvaluationFilter) (map[string]EvaluationResult, error) {
+	return c.evaluateAll(ctx, c.context, filter)
+}
+
+// evaluateAll evaluates for mergedContextSet, which already includes the bound context
+func (c *ContextBoundClient) evaluateAll(ctx context.Context, mergedContextSet *ContextSet, filter EvaluationFilter) (map[string]EvaluationResult, error) {
+	if err := c.client.readyForEvaluation(ctx); err != nil {
+		return nil, err
+	}
+
+	snapshot := stores.Snapshot(c.client.configStore, mergedContextSet)
+
+	resolver := *c.client.configResolver
+	resolver.ConfigStore = snapshot
+	resolver.RuleEvaluator = internal.NewConfigRuleEvaluator(snapshot, snapshot)
+	resolver.ContextGetter = snapshot
+
+	results := make(map[string]EvaluationResult, len(snapshot.Configs()))
+	matches := make([]internal.ConfigMatch, 0, len(snapshot.Configs()))
+
+	for key, config := range snapshot.Configs() {
+		// Deleted configs can linger without rows
+		if len(config.GetRows()) == 0 || (filter != nil && !filter(config)) {
+			continue
+		}
+
+		result := EvaluationResult{Key: key}
+
+		match, err := resolver.ResolveValueForConfig(config, mergedContextSet, key)
+		result.Match = match
+
+		switch {
+		case err != nil:
+			result.Err = err
+		case match.IsMatch && match.Match != nil:
+			result.Value, _, result.Err = ExtractValue(match.Match)
+			result.Found = result.Err == nil
+
+			matches = append(matches, match)
+		}
+
+		results[key] = result
+	}
+
+	c.client.telemetry.RecordContext(mergedContextSet)
+	c.client.telemetry.RecordEvaluations(matches)
+
+	return results, nil
+}
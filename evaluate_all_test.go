@@ -0,0 +1,79 @@
+package reforge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+func TestEvaluateAll(t *testing.T) {
+	flag := staticConfig(t, "flags.checkout", true)
+	flag.ConfigType = prefabProto.ConfigType_FEATURE_FLAG
+
+	store := &mutableTestStore{configs: map[string]*prefabProto.Config{}}
+	store.set(tieredConfig(t, 1, 500, 100))
+	store.set(staticConfig(t, "banner.text", "hello"))
+	store.set(flag)
+	store.set(&prefabProto.Config{Key: "deleted", Id: 2})
+
+	client, err := NewSdk(WithCustomStore(store), WithOfflineSources([]string{}), WithAllTelemetryDisabled())
+	require.NoError(t, err)
+
+	undo, err := client.Override("banner.text", "for gold only", OverrideWhen(func(contextSet ContextValueGetter) bool {
+		tier, _ := contextSet.GetContextValue("user.tier")
+
+		return tier == "gold"
+	}))
+	require.NoError(t, err)
+
+	defer undo()
+
+	gold := NewContextSet().WithNamedContextValues("user", map[string]interface{}{"tier": "gold"})
+	silver := NewContextSet().WithNamedContextValues("user", map[string]interface{}{"tier": "silver"})
+
+	t.Run("evaluates every config for the bound context", func(t *testing.T) {
+		results, err := client.WithContext(gold).EvaluateAll(nil)
+		require.NoError(t, err)
+
+		assert.Len(t, results, 3)
+		assert.Equal(t, int64(500), results["kafka.batch.size"].Value)
+		assert.Equal(t, "for gold only", results["banner.text"].Value)
+		assert.Equal(t, true, results["flags.checkout"].Value)
+		assert.Equal(t, prefabProto.ConfigType_FEATURE_FLAG, results["flags.checkout"].Match.ConfigType)
+
+		for key, result := range results {
+			assert.Equal(t, key, result.Key)
+			assert.True(t, result.Found, key)
+			assert.NoError(t, result.Err, key)
+		}
+	})
+
+	t.Run("matches individual evaluations", func(t *testing.T) {
+		results, err := client.EvaluateAll(*silver, nil)
+		require.NoError(t, err)
+
+		for key, result := range results {
+			match, err := client.GetConfigMatch(key, *silver)
+			require.NoError(t, err)
+
+			assert.Equal(t, match.Match.String(), result.Match.Match.String(), key)
+		}
+
+		assert.Equal(t, "hello", results["banner.text"].Value)
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		results, err := client.EvaluateAll(*gold, ConfigTypeFilter(prefabProto.ConfigType_FEATURE_FLAG))
+		require.NoError(t, err)
+		assert.Len(t, results, 1)
+		assert.Contains(t, results, "flags.checkout")
+
+		results, err = client.EvaluateAll(*gold, KeyPrefixFilter("kafka."))
+		require.NoError(t, err)
+		assert.Len(t, results, 1)
+		assert.Contains(t, results, "kafka.batch.size")
+	})
+}
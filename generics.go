@@ -0,0 +1,144 @@
+package reforge
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ReforgeHQ/sdk-go/internal/utils"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+// BoundClient is implemented by *Client and *ContextBoundClient so either can
+// be passed to the package-level generic getters.
+type BoundClient interface {
+	contextBoundClient() *ContextBoundClient
+}
+
+func (c *Client) contextBoundClient() *ContextBoundClient {
+	return c.boundClient
+}
+
+func (c *ContextBoundClient) contextBoundClient() *ContextBoundClient {
+	return c
+}
+
+// ValueType lists the Go types Get can return.
+type ValueType interface {
+	int64 | bool | string | float64 | []string | time.Duration | []byte | IntRange
+}
+
+// Get returns the value of key evaluated for contextSet as T. Unlike the
+// per-type methods it reports a value of another type as an error wrapping
+// ErrTypeMismatch instead of returning ok=false.
+//
+//	batchSize, ok, err := reforge.Get[int64](client, "kafka.batch.size", *reforge.NewContextSet())
+func Get[T ValueType](client BoundClient, key string, contextSet ContextSet) (T, bool, error) {
+	return getTyped(context.Background(), client.contextBoundClient(), key, contextSet, extractorFor[T]())
+}
+
+// GetCtx is Get, giving up waiting for initialization when ctx is done.
+func GetCtx[T ValueType](ctx context.Context, client BoundClient, key string, contextSet ContextSet) (T, bool, error) {
+	return getTyped(ctx, client.contextBoundClient(), key, contextSet, extractorFor[T]())
+}
+
+// JSONOption adjusts how GetJSONAs decodes a JSON config.
+type JSONOption func(*jsonDecodeOptions)
+
+type jsonDecodeOptions struct {
+	allowUnknownFields bool
+}
+
+// AllowUnknownJSONFields lets GetJSONAs ignore JSON object keys that have no matching struct field.
+// By default they are an error.
+func AllowUnknownJSONFields() JSONOption {
+	return func(o *jsonDecodeOptions) {
+		o.allowUnknownFields = true
+	}
+}
+
+// GetJSONAs decodes the JSON value of key evaluated for contextSet into a T.
+// Object keys without a matching field in T are an error unless
+// AllowUnknownJSONFields is passed. A config that isn't JSON returns an
+// error wrapping ErrTypeMismatch.
+//
+//	type Retry struct {
+//		Max     int    `json:"max"`
+//		Backoff string `json:"backoff"`
+//	}
+//
+//	retry, ok, err := reforge.GetJSONAs[Retry](client, "payments.retry", *reforge.NewContextSet())
+func GetJSONAs[T any](client BoundClient, key string, contextSet ContextSet, opts ...JSONOption) (T, bool, error) {
+	var result T
+
+	raw, ok, err := getTyped(context.Background(), client.contextBoundClient(), key, contextSet, utils.ExtractJSONStringValue)
+	if err != nil || !ok {
+		return result, false, err
+	}
+
+	decodeOptions := jsonDecodeOptions{}
+	for _, opt := range opts {
+		opt(&decodeOptions)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(raw)))
+	if !decodeOptions.allowUnknownFields {
+		decoder.DisallowUnknownFields()
+	}
+
+	if err := decoder.Decode(&result); err != nil {
+		var zero T
+
+		return zero, false, fmt.Errorf("decoding JSON config %q into %T: %w", key, result, err)
+	}
+
+	return result, true, nil
+}
+
+func getTyped[T any](ctx context.Context, client *ContextBoundClient, key string, contextSet ContextSet, extract func(*prefabProto.ConfigValue) (T, bool)) (T, bool, error) {
+	var seen *prefabProto.ConfigValue
+
+	value, ok, err := clientInternalGetValueFunc(ctx, client, key, contextSet, func(cv *prefabProto.ConfigValue) (T, bool) {
+		seen = cv
+
+		return extract(cv)
+	})
+
+	if err == nil && !ok && seen != nil {
+		var zero T
+
+		return zero, false, fmt.Errorf("%w: %q holds a %s value, not %T", ErrTypeMismatch, key, utils.GetValueType(seen), zero)
+	}
+
+	return value, ok, err
+}
+
+func extractorFor[T ValueType]() func(*prefabProto.ConfigValue) (T, bool) {
+	var (
+		zero      T
+		extractor any
+	)
+
+	switch any(zero).(type) {
+	case int64:
+		extractor = utils.ExtractIntValue
+	case bool:
+		extractor = utils.ExtractBoolValue
+	case string:
+		extractor = utils.ExtractStringValue
+	case float64:
+		extractor = utils.ExtractFloatValue
+	case []string:
+		extractor = utils.ExtractStringListValue
+	case time.Duration:
+		extractor = utils.ExtractDurationValue
+	case []byte:
+		extractor = utils.ExtractBytesValue
+	case IntRange:
+		extractor = extractIntRangeValue
+	}
+
+	return extractor.(func(*prefabProto.ConfigValue) (T, bool))
+}
@@ -0,0 +1,115 @@
+package reforge_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	reforge "github.com/ReforgeHQ/sdk-go"
+)
+
+type retryPolicy struct {
+	Backoff string `json:"backoff"`
+	Max     int    `json:"max"`
+}
+
+func newGenericsTestClient(t *testing.T) *reforge.Client {
+	t.Helper()
+
+	client, err := reforge.NewSdk(
+		reforge.WithConfigs(map[string]interface{}{
+			"int.key":      int64(42),
+			"string.key":   "value",
+			"bool.key":     true,
+			"float.key":    3.14,
+			"slice.key":    []string{"a", "b"},
+			"duration.key": 90 * time.Second,
+			"retry":        map[string]interface{}{"max": 3, "backoff": "exponential"},
+			"retry.extra":  map[string]interface{}{"max": 3, "backoff": "linear", "jitter": true},
+			"retry.wrong":  map[string]interface{}{"max": "three"},
+		}),
+		reforge.WithAllTelemetryDisabled(),
+	)
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestGet(t *testing.T) {
+	client := newGenericsTestClient(t)
+	ctx := *reforge.NewContextSet()
+
+	i, ok, err := reforge.Get[int64](client, "int.key", ctx)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int64(42), i)
+
+	s, ok, err := reforge.Get[string](client.WithContext(reforge.NewContextSet()), "string.key", ctx)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "value", s)
+
+	b, ok, err := reforge.Get[bool](client, "bool.key", ctx)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, b)
+
+	f, ok, err := reforge.Get[float64](client, "float.key", ctx)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.InDelta(t, 3.14, f, 0.0001)
+
+	slice, ok, err := reforge.Get[[]string](client, "slice.key", ctx)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"a", "b"}, slice)
+
+	d, ok, err := reforge.Get[time.Duration](client, "duration.key", ctx)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 90*time.Second, d)
+
+	_, ok, err = reforge.Get[int64](client, "string.key", ctx)
+	require.ErrorIs(t, err, reforge.ErrTypeMismatch)
+	assert.False(t, ok)
+	assert.Contains(t, err.Error(), `"string.key" holds a STRING value, not int64`)
+
+	_, ok, err = reforge.Get[int64](client, "missing.key", ctx)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, reforge.ErrTypeMismatch)
+	assert.False(t, ok)
+}
+
+func TestGetJSONAs(t *testing.T) {
+	client := newGenericsTestClient(t)
+	ctx := *reforge.NewContextSet()
+
+	policy, ok, err := reforge.GetJSONAs[retryPolicy](client, "retry", ctx)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, retryPolicy{Max: 3, Backoff: "exponential"}, policy)
+
+	_, ok, err = reforge.GetJSONAs[retryPolicy](client, "retry.extra", ctx)
+	require.ErrorContains(t, err, `unknown field "jitter"`)
+	assert.False(t, ok)
+
+	policy, ok, err = reforge.GetJSONAs[retryPolicy](client, "retry.extra", ctx, reforge.AllowUnknownJSONFields())
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, retryPolicy{Max: 3, Backoff: "linear"}, policy)
+
+	_, ok, err = reforge.GetJSONAs[retryPolicy](client, "retry.wrong", ctx)
+	require.ErrorContains(t, err, `decoding JSON config "retry.wrong" into reforge_test.retryPolicy`)
+	assert.False(t, ok)
+
+	_, ok, err = reforge.GetJSONAs[retryPolicy](client, "int.key", ctx)
+	require.ErrorIs(t, err, reforge.ErrTypeMismatch)
+	assert.False(t, ok)
+
+	asMap, ok, err := reforge.GetJSONAs[map[string]any](client, "retry", ctx)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "exponential", asMap["backoff"])
+}
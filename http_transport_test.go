@@ -0,0 +1,106 @@
+package reforge_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	reforge "github.com/ReforgeHQ/sdk-go"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+type recordingTransport struct {
+	requests []*http.Request
+	mutex    sync.Mutex
+}
+
+func (rt *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.mutex.Lock()
+	rt.requests = append(rt.requests, req)
+	rt.mutex.Unlock()
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func (rt *recordingTransport) paths() map[string]http.Header {
+	rt.mutex.Lock()
+	defer rt.mutex.Unlock()
+
+	paths := make(map[string]http.Header, len(rt.requests))
+	for _, req := range rt.requests {
+		paths[req.URL.Path] = req.Header
+	}
+
+	return paths
+}
+
+func TestWithTransportIsUsedForConfigFetchesAndStreaming(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/sse/config" {
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+
+			return
+		}
+
+		body, err := proto.Marshal(&prefabProto.Configs{Configs: []*prefabProto.Config{{
+			Key:  "greeting",
+			Id:   1,
+			Rows: []*prefabProto.ConfigRow{{Values: []*prefabProto.ConditionalValue{{Value: &prefabProto.ConfigValue{Type: &prefabProto.ConfigValue_String_{String_: "hello"}}}}}},
+		}}})
+		assert.NoError(t, err)
+
+		_, err = w.Write(body)
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	transport := &recordingTransport{}
+
+	client, err := reforge.NewSdk(
+		reforge.WithSdkKey("test-key"),
+		reforge.WithAPIURLs([]string{server.URL}),
+		reforge.WithTransport(transport),
+		reforge.WithHTTPTimeout(5*time.Second),
+		reforge.WithHTTPHeaders(map[string]string{"X-Proxy-Token": "abc", "Authorization": "ignored"}),
+		reforge.WithAllTelemetryDisabled(),
+	)
+	require.NoError(t, err)
+
+	defer client.Close(context.Background())
+
+	greeting, ok, err := client.GetStringValue("greeting", *reforge.NewContextSet())
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "hello", greeting)
+
+	require.Eventually(t, func() bool {
+		_, streamed := transport.paths()["/api/v2/sse/config"]
+
+		return streamed
+	}, 2*time.Second, 5*time.Millisecond)
+
+	for path, header := range transport.paths() {
+		assert.Equal(t, "abc", header.Get("X-Proxy-Token"), path)
+		assert.NotEqual(t, "ignored", header.Get("Authorization"), path)
+	}
+}
+
+func TestHTTPOptionsRejectInvalidValues(t *testing.T) {
+	_, err := reforge.NewSdk(reforge.WithHTTPClient(nil))
+	require.Error(t, err)
+
+	_, err = reforge.NewSdk(reforge.WithTransport(nil))
+	require.Error(t, err)
+
+	_, err = reforge.NewSdk(reforge.WithHTTPTimeout(0))
+	require.Error(t, err)
+}
@@ -0,0 +1,57 @@
+package reforge
+
+import (
+	"math"
+
+	"github.com/ReforgeHQ/sdk-go/internal/utils"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+// IntRange is an int range config value. Start is inclusive and End is
+// exclusive; an open bound is math.MinInt64 or math.MaxInt64.
+type IntRange struct {
+	Start int64
+	End   int64
+}
+
+// Contains reports whether n is in the range
+func (r IntRange) Contains(n int64) bool {
+	return n >= r.Start && n < r.End
+}
+
+func intRangeFromProto(intRange *prefabProto.IntRange) IntRange {
+	result := IntRange{Start: math.MinInt64, End: math.MaxInt64}
+
+	if intRange.Start != nil {
+		result.Start = intRange.GetStart()
+	}
+
+	if intRange.End != nil {
+		result.End = intRange.GetEnd()
+	}
+
+	return result
+}
+
+func intRangeToProto(intRange IntRange) *prefabProto.IntRange {
+	result := &prefabProto.IntRange{}
+
+	if intRange.Start != math.MinInt64 {
+		result.Start = &intRange.Start
+	}
+
+	if intRange.End != math.MaxInt64 {
+		result.End = &intRange.End
+	}
+
+	return result
+}
+
+func extractIntRangeValue(cv *prefabProto.ConfigValue) (IntRange, bool) {
+	intRange, ok := utils.ExtractIntRangeValue(cv)
+	if !ok {
+		return IntRange{}, false
+	}
+
+	return intRangeFromProto(intRange), true
+}
@@ -0,0 +1,83 @@
+package reforge_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	reforge "github.com/ReforgeHQ/sdk-go"
+	"github.com/ReforgeHQ/sdk-go/internal"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+func TestBytesAndIntRangeValues(t *testing.T) {
+	client, err := reforge.NewSdk(
+		reforge.WithConfigs(map[string]interface{}{
+			"pins.leaf":     []byte{0xde, 0xad, 0xbe, 0xef},
+			"ranges.adults": &prefabProto.IntRange{Start: internal.Int64Ptr(18), End: internal.Int64Ptr(65)},
+			"ranges.open":   &prefabProto.IntRange{Start: internal.Int64Ptr(100)},
+			"string.key":    "value",
+		}),
+		reforge.WithAllTelemetryDisabled(),
+	)
+	require.NoError(t, err)
+
+	ctx := *reforge.NewContextSet()
+
+	pin, ok, err := client.GetBytesValue("pins.leaf", ctx)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte{0xde, 0xad, 0xbe, 0xef}, pin)
+
+	adults, ok, err := client.GetIntRangeValue("ranges.adults", ctx)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, reforge.IntRange{Start: 18, End: 65}, adults)
+	assert.True(t, adults.Contains(18))
+	assert.False(t, adults.Contains(65))
+
+	open, ok, err := client.WithContext(reforge.NewContextSet()).GetIntRangeValue("ranges.open", ctx)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, reforge.IntRange{Start: 100, End: math.MaxInt64}, open)
+	assert.True(t, open.Contains(math.MaxInt64-1))
+
+	t.Run("defaults", func(t *testing.T) {
+		fallback := []byte("fallback")
+
+		value, _ := client.GetBytesValueWithDefault("string.key", ctx, fallback)
+		assert.Equal(t, fallback, value)
+
+		intRange, _ := client.GetIntRangeValueWithDefault("missing", ctx, reforge.IntRange{Start: 1, End: 2})
+		assert.Equal(t, reforge.IntRange{Start: 1, End: 2}, intRange)
+	})
+
+	t.Run("generic getter", func(t *testing.T) {
+		intRange, ok, err := reforge.Get[reforge.IntRange](client, "ranges.adults", ctx)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, int64(18), intRange.Start)
+
+		_, _, err = reforge.Get[[]byte](client, "string.key", ctx)
+		require.ErrorIs(t, err, reforge.ErrTypeMismatch)
+	})
+
+	t.Run("details", func(t *testing.T) {
+		details, err := client.GetDetails("ranges.adults", ctx)
+		require.NoError(t, err)
+		assert.Equal(t, reforge.IntRange{Start: 18, End: 65}, details.Value)
+	})
+
+	t.Run("overrides", func(t *testing.T) {
+		undo, err := client.Override("ranges.adults", reforge.IntRange{Start: 21, End: math.MaxInt64})
+		require.NoError(t, err)
+
+		defer undo()
+
+		intRange, _, err := client.GetIntRangeValue("ranges.adults", ctx)
+		require.NoError(t, err)
+		assert.Equal(t, reforge.IntRange{Start: 21, End: math.MaxInt64}, intRange)
+	})
+}
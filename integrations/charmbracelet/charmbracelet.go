@@ -8,6 +8,12 @@ import (
 	"github.com/charmbracelet/log"
 )
 
+// logRecorder is satisfied by reforge.Client. Other ClientInterface
+// implementations don't have to count records.
+type logRecorder interface {
+	RecordLog(loggerName string, level reforge.LogLevel)
+}
+
 // ReforgeCharmLogger wraps a charmbracelet log.Logger and provides dynamic
 // log level filtering based on Reforge configuration. It checks the Reforge
 // configuration on every log call for real-time log level updates.
@@ -39,9 +45,23 @@ func (l *ReforgeCharmLogger) isEnabled(level reforge.LogLevel) bool {
 	return level >= configuredLevel
 }
 
+// record counts a record in logger telemetry if the wrapped logger's own
+// level lets it through and the client has a RecordLog method, as
+// reforge.Client does.
+func (l *ReforgeCharmLogger) record(level reforge.LogLevel, charmLevel log.Level) {
+	if l.logger.GetLevel() > charmLevel {
+		return
+	}
+
+	if recorder, ok := l.client.(logRecorder); ok {
+		recorder.RecordLog(l.loggerName, level)
+	}
+}
+
 // Debug logs a debug message if enabled
 func (l *ReforgeCharmLogger) Debug(msg interface{}, keyvals ...interface{}) {
 	if l.isEnabled(reforge.Debug) {
+		l.record(reforge.Debug, log.DebugLevel)
 		l.logger.Debug(msg, keyvals...)
 	}
 }
@@ -49,6 +69,7 @@ func (l *ReforgeCharmLogger) Debug(msg interface{}, keyvals ...interface{}) {
 // Debugf logs a formatted debug message if enabled
 func (l *ReforgeCharmLogger) Debugf(format string, args ...interface{}) {
 	if l.isEnabled(reforge.Debug) {
+		l.record(reforge.Debug, log.DebugLevel)
 		l.logger.Debugf(format, args...)
 	}
 }
@@ -56,6 +77,7 @@ func (l *ReforgeCharmLogger) Debugf(format string, args ...interface{}) {
 // Info logs an info message if enabled
 func (l *ReforgeCharmLogger) Info(msg interface{}, keyvals ...interface{}) {
 	if l.isEnabled(reforge.Info) {
+		l.record(reforge.Info, log.InfoLevel)
 		l.logger.Info(msg, keyvals...)
 	}
 }
@@ -63,6 +85,7 @@ func (l *ReforgeCharmLogger) Info(msg interface{}, keyvals ...interface{}) {
 // Infof logs a formatted info message if enabled
 func (l *ReforgeCharmLogger) Infof(format string, args ...interface{}) {
 	if l.isEnabled(reforge.Info) {
+		l.record(reforge.Info, log.InfoLevel)
 		l.logger.Infof(format, args...)
 	}
 }
@@ -70,6 +93,7 @@ func (l *ReforgeCharmLogger) Infof(format string, args ...interface{}) {
 // Warn logs a warning message if enabled
 func (l *ReforgeCharmLogger) Warn(msg interface{}, keyvals ...interface{}) {
 	if l.isEnabled(reforge.Warn) {
+		l.record(reforge.Warn, log.WarnLevel)
 		l.logger.Warn(msg, keyvals...)
 	}
 }
@@ -77,6 +101,7 @@ func (l *ReforgeCharmLogger) Warn(msg interface{}, keyvals ...interface{}) {
 // Warnf logs a formatted warning message if enabled
 func (l *ReforgeCharmLogger) Warnf(format string, args ...interface{}) {
 	if l.isEnabled(reforge.Warn) {
+		l.record(reforge.Warn, log.WarnLevel)
 		l.logger.Warnf(format, args...)
 	}
 }
@@ -84,6 +109,7 @@ func (l *ReforgeCharmLogger) Warnf(format string, args ...interface{}) {
 // Error logs an error message if enabled
 func (l *ReforgeCharmLogger) Error(msg interface{}, keyvals ...interface{}) {
 	if l.isEnabled(reforge.Error) {
+		l.record(reforge.Error, log.ErrorLevel)
 		l.logger.Error(msg, keyvals...)
 	}
 }
@@ -91,17 +117,20 @@ func (l *ReforgeCharmLogger) Error(msg interface{}, keyvals ...interface{}) {
 // Errorf logs a formatted error message if enabled
 func (l *ReforgeCharmLogger) Errorf(format string, args ...interface{}) {
 	if l.isEnabled(reforge.Error) {
+		l.record(reforge.Error, log.ErrorLevel)
 		l.logger.Errorf(format, args...)
 	}
 }
 
 // Fatal logs a fatal message (always logged)
 func (l *ReforgeCharmLogger) Fatal(msg interface{}, keyvals ...interface{}) {
+	l.record(reforge.Fatal, log.FatalLevel)
 	l.logger.Fatal(msg, keyvals...)
 }
 
 // Fatalf logs a formatted fatal message (always logged)
 func (l *ReforgeCharmLogger) Fatalf(format string, args ...interface{}) {
+	l.record(reforge.Fatal, log.FatalLevel)
 	l.logger.Fatalf(format, args...)
 }
 
@@ -5,8 +5,15 @@ import (
 	"go.uber.org/zap/zapcore"
 )
 
+// logRecorder is implemented by clients that count log records, such as
+// reforge.Client
+type logRecorder interface {
+	RecordLog(loggerName string, level reforge.LogLevel)
+}
+
 // ReforgeZapLevel is a dynamic zap level that queries Reforge for the
-// appropriate log level. It implements zapcore.LevelEnabler.
+// appropriate log level. It implements zapcore.LevelEnabler. It doesn't see
+// individual entries, so use ReforgeZapCore to count them in logger telemetry.
 type ReforgeZapLevel struct {
 	client     reforge.ClientInterface
 	loggerName string
@@ -95,7 +102,23 @@ func (c *ReforgeZapCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntr
 	if !c.Enabled(entry.Level) {
 		return checked
 	}
-	return c.Core.Check(entry, checked)
+
+	// Ask the wrapped core first so its own filtering, such as sampling, still
+	// applies, then add this core so Write sees the entries that are written
+	if c.Core.Check(entry, nil) == nil {
+		return checked
+	}
+	return checked.AddCore(entry, c)
+}
+
+// Write writes the entry to the wrapped core. If the client has a RecordLog
+// method, as reforge.Client does, the entry is counted in logger telemetry.
+func (c *ReforgeZapCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	if recorder, ok := c.client.(logRecorder); ok {
+		recorder.RecordLog(c.loggerName, zapToReforgeLevel(entry.Level))
+	}
+
+	return c.Core.Write(entry, fields)
 }
 
 // With adds structured context to the Core.
@@ -107,6 +130,25 @@ func (c *ReforgeZapCore) With(fields []zapcore.Field) zapcore.Core {
 	}
 }
 
+// zapToReforgeLevel converts a zapcore.Level to a Reforge LogLevel. DPanic and
+// Panic are counted as Fatal, the only Reforge level above Error.
+func zapToReforgeLevel(level zapcore.Level) reforge.LogLevel {
+	switch {
+	case level < zapcore.DebugLevel:
+		return reforge.Trace
+	case level == zapcore.DebugLevel:
+		return reforge.Debug
+	case level == zapcore.InfoLevel:
+		return reforge.Info
+	case level == zapcore.WarnLevel:
+		return reforge.Warn
+	case level == zapcore.ErrorLevel:
+		return reforge.Error
+	default:
+		return reforge.Fatal
+	}
+}
+
 // reforgeToZapLevel converts a Reforge LogLevel to zapcore.Level
 func (c *ReforgeZapCore) reforgeToZapLevel(level reforge.LogLevel) zapcore.Level {
 	switch level {
@@ -5,6 +5,11 @@ import (
 	"github.com/rs/zerolog"
 )
 
+// logRecorder is the optional client method the hook counts events with
+type logRecorder interface {
+	RecordLog(loggerName string, level reforge.LogLevel)
+}
+
 // ReforgeZerologHook is a zerolog Hook that filters log events based on
 // Reforge-configured log levels. It queries Reforge for the log level
 // dynamically on each log event.
@@ -28,7 +33,9 @@ func NewReforgeZerologHook(client reforge.ClientInterface, loggerName string) *R
 	}
 }
 
-// Run implements zerolog.Hook interface
+// Run implements zerolog.Hook interface. If the client has a RecordLog
+// method, as reforge.Client does, leveled events that aren't discarded are
+// counted in logger telemetry.
 func (h *ReforgeZerologHook) Run(e *zerolog.Event, level zerolog.Level, msg string) {
 	reforgeLevel := h.client.GetLogLevel(h.loggerName)
 	zerologLevel := h.reforgeToZerologLevel(reforgeLevel)
@@ -36,6 +43,34 @@ func (h *ReforgeZerologHook) Run(e *zerolog.Event, level zerolog.Level, msg stri
 	// If the event level is less severe than configured level, disable it
 	if level < zerologLevel {
 		e.Discard()
+
+		return
+	}
+
+	recorder, canRecord := h.client.(logRecorder)
+	if eventLevel, ok := zerologToReforgeLevel(level); ok && canRecord {
+		recorder.RecordLog(h.loggerName, eventLevel)
+	}
+}
+
+// zerologToReforgeLevel converts a zerolog.Level to a Reforge LogLevel. Panic
+// is counted as Fatal; events without a level are not counted.
+func zerologToReforgeLevel(level zerolog.Level) (reforge.LogLevel, bool) {
+	switch level {
+	case zerolog.TraceLevel:
+		return reforge.Trace, true
+	case zerolog.DebugLevel:
+		return reforge.Debug, true
+	case zerolog.InfoLevel:
+		return reforge.Info, true
+	case zerolog.WarnLevel:
+		return reforge.Warn, true
+	case zerolog.ErrorLevel:
+		return reforge.Error, true
+	case zerolog.FatalLevel, zerolog.PanicLevel:
+		return reforge.Fatal, true
+	default:
+		return 0, false
 	}
 }
 
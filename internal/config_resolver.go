@@ -1,7 +1,9 @@
 package internal
 
 import (
+	"encoding/base64"
 	"errors"
+	"fmt"
 	"os"
 	"strconv"
 	"time"
@@ -44,6 +46,7 @@ var (
 	ErrConfigDoesNotExist = errors.New("config does not exist")
 	ErrEnvVarNotExist     = errors.New("environment variable does not exist")
 	ErrTypeCoercionFailed = errors.New("type coercion failed on value from environment variable")
+	ErrDecryption         = errors.New("decryption failed")
 )
 
 type ConfigResolver struct {
@@ -71,7 +74,7 @@ func (c ConfigResolver) Keys() []string {
 }
 
 func (c ConfigResolver) ResolveValue(key string, contextSet ContextValueGetter) (ConfigMatch, error) {
-	config, configExists := c.ConfigStore.GetConfig(key)
+	config, configExists := c.getConfig(key, contextSet)
 	if !configExists {
 		return ConfigMatch{IsMatch: false, ConfigKey: key}, ErrConfigDoesNotExist
 	}
@@ -79,10 +82,42 @@ func (c ConfigResolver) ResolveValue(key string, contextSet ContextValueGetter)
 	return c.ResolveValueForConfig(config, contextSet, key)
 }
 
+// ResolveValueWithTrace is ResolveValue, also returning the criteria evaluated to reach the result.
+// The trace is empty if the RuleEvaluator does not implement TracingConfigEvaluator.
+func (c ConfigResolver) ResolveValueWithTrace(key string, contextSet ContextValueGetter) (ConfigMatch, *EvaluationTrace, error) {
+	trace := &EvaluationTrace{}
+
+	config, configExists := c.getConfig(key, contextSet)
+	if !configExists {
+		return ConfigMatch{IsMatch: false, ConfigKey: key}, trace, ErrConfigDoesNotExist
+	}
+
+	match, err := c.resolveValueForConfig(config, contextSet, key, trace)
+
+	return match, trace, err
+}
+
+func (c ConfigResolver) getConfig(key string, contextSet ContextValueGetter) (*prefabProto.Config, bool) {
+	if contextualStore, ok := c.ConfigStore.(ContextualConfigStoreGetter); ok {
+		return contextualStore.GetConfigForContext(key, contextSet)
+	}
+
+	return c.ConfigStore.GetConfig(key)
+}
+
 func (c ConfigResolver) ResolveValueForConfig(config *prefabProto.Config, contextSet ContextValueGetter, key string) (ConfigMatch, error) {
+	return c.resolveValueForConfig(config, contextSet, key, nil)
+}
+
+func (c ConfigResolver) resolveValueForConfig(config *prefabProto.Config, contextSet ContextValueGetter, key string, trace *EvaluationTrace) (ConfigMatch, error) {
 	contextSet = makeMultiContextGetter(contextSet, c.ContextGetter)
 
-	ruleMatchResults := c.RuleEvaluator.EvaluateConfig(config, contextSet)
+	var ruleMatchResults ConditionMatch
+	if tracingEvaluator, ok := c.RuleEvaluator.(TracingConfigEvaluator); ok && trace != nil {
+		ruleMatchResults = tracingEvaluator.EvaluateConfigWithTrace(config, contextSet, trace)
+	} else {
+		ruleMatchResults = c.RuleEvaluator.EvaluateConfig(config, contextSet)
+	}
 	configMatch := NewConfigMatchFromConditionMatch(ruleMatchResults)
 	configMatch.ConfigKey = key
 	configMatch.ConfigType = config.GetConfigType()
@@ -116,7 +151,7 @@ func (c ConfigResolver) ResolveValueForConfig(config *prefabProto.Config, contex
 				configMatch.Match = value
 				configMatch.Match.Confidential = BoolPtr(true)
 			} else {
-				return configMatch, err
+				return configMatch, fmt.Errorf("%w: %w", ErrDecryption, err)
 			}
 		}
 	}
@@ -159,6 +194,14 @@ func coerceValue(value string, valueType prefabProto.Config_ValueType) (any, boo
 		if bValue, err := strconv.ParseBool(value); err == nil {
 			return bValue, true
 		}
+	case prefabProto.Config_BYTES:
+		if decoded, err := base64.StdEncoding.DecodeString(value); err == nil {
+			return decoded, true
+		}
+	case prefabProto.Config_INT_RANGE:
+		if intRange, err := utils.ParseIntRange(value); err == nil {
+			return intRange, true
+		}
 	}
 
 	return nil, false
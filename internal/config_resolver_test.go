@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/ReforgeHQ/sdk-go/internal"
 	"github.com/ReforgeHQ/sdk-go/internal/mocks"
@@ -589,3 +591,100 @@ func TestConfigResolver_CustomEnvLookupReturnsNotFound(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, internal.ErrEnvVarNotExist, err)
 }
+
+func TestConfigResolver_CoercesProvidedBytesAndIntRange(t *testing.T) {
+	theKey := "the.key"
+	providedEnvVarName := "PROVIDED_ENV"
+	envVarSource := prefabProto.ProvidedSource_ENV_VAR
+
+	providedConfigValue := &prefabProto.ConfigValue{
+		Type: &prefabProto.ConfigValue_Provided{Provided: &prefabProto.Provided{Lookup: &providedEnvVarName, Source: &envVarSource}},
+	}
+
+	tests := []struct {
+		name      string
+		valueType prefabProto.Config_ValueType
+		envValue  string
+		want      *prefabProto.ConfigValue
+		wantErr   error
+	}{
+		{
+			name:      "base64 bytes",
+			valueType: prefabProto.Config_BYTES,
+			envValue:  "3q2+7w==",
+			want:      &prefabProto.ConfigValue{Type: &prefabProto.ConfigValue_Bytes{Bytes: []byte{0xde, 0xad, 0xbe, 0xef}}},
+		},
+		{
+			name:      "invalid base64",
+			valueType: prefabProto.Config_BYTES,
+			envValue:  "not base64!",
+			wantErr:   internal.ErrTypeCoercionFailed,
+		},
+		{
+			name:      "int range",
+			valueType: prefabProto.Config_INT_RANGE,
+			envValue:  "10..20",
+			want: &prefabProto.ConfigValue{Type: &prefabProto.ConfigValue_IntRange{
+				IntRange: &prefabProto.IntRange{Start: internal.Int64Ptr(10), End: internal.Int64Ptr(20)},
+			}},
+		},
+		{
+			name:      "open int range",
+			valueType: prefabProto.Config_INT_RANGE,
+			envValue:  "10..",
+			want: &prefabProto.ConfigValue{Type: &prefabProto.ConfigValue_IntRange{
+				IntRange: &prefabProto.IntRange{Start: internal.Int64Ptr(10)},
+			}},
+		},
+		{
+			name:      "invalid int range",
+			valueType: prefabProto.Config_INT_RANGE,
+			envValue:  "10-20",
+			wantErr:   internal.ErrTypeCoercionFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &prefabProto.Config{Key: theKey, ConfigType: prefabProto.ConfigType_CONFIG, ValueType: tt.valueType}
+
+			mockConfigEvaluator := newMockConfigEvaluator([]mockConfigEvaluatorArgs{
+				{
+					config: config,
+					match: internal.ConditionMatch{
+						IsMatch:               true,
+						Match:                 providedConfigValue,
+						RowIndex:              internal.IntPtr(1),
+						ConditionalValueIndex: internal.IntPtr(1),
+					},
+				},
+			})
+			defer mockConfigEvaluator.AssertExpectations(t)
+
+			mockConfigStoreGetter := mocks.NewMockConfigStoreGetter([]mocks.ConfigMockingArgs{
+				{
+					ConfigKey:    theKey,
+					Config:       config,
+					ConfigExists: true,
+				},
+			})
+			defer mockConfigStoreGetter.AssertExpectations(t)
+
+			resolver := &internal.ConfigResolver{
+				ConfigStore:   mockConfigStoreGetter,
+				RuleEvaluator: mockConfigEvaluator,
+				EnvLookup:     &mockEnvLookup{values: map[string]string{providedEnvVarName: tt.envValue}},
+			}
+
+			match, err := resolver.ResolveValue(theKey, new(mocks.MockContextGetter))
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, proto.Equal(tt.want, match.Match), "got %v", match.Match)
+		})
+	}
+}
@@ -22,6 +22,47 @@ type ConditionMatch struct {
 	IsMatch               bool
 }
 
+// CriterionEvaluation records the outcome of a single criterion, along with
+// the context value it was compared against.
+type CriterionEvaluation struct {
+	Criterion             *prefabProto.Criterion
+	ContextValue          any
+	RowIndex              int
+	ConditionalValueIndex int
+	ContextValueExists    bool
+	Matched               bool
+}
+
+// EvaluationTrace collects every criterion evaluated while resolving a config.
+// A nil trace records nothing.
+type EvaluationTrace struct {
+	Criteria              []CriterionEvaluation
+	rowIndex              int
+	conditionalValueIndex int
+}
+
+func (t *EvaluationTrace) at(rowIndex int, conditionalValueIndex int) {
+	if t != nil {
+		t.rowIndex = rowIndex
+		t.conditionalValueIndex = conditionalValueIndex
+	}
+}
+
+func (t *EvaluationTrace) record(criterion *prefabProto.Criterion, contextValue any, contextValueExists bool, matched bool) {
+	if t == nil {
+		return
+	}
+
+	t.Criteria = append(t.Criteria, CriterionEvaluation{
+		Criterion:             criterion,
+		ContextValue:          contextValue,
+		RowIndex:              t.rowIndex,
+		ConditionalValueIndex: t.conditionalValueIndex,
+		ContextValueExists:    contextValueExists,
+		Matched:               matched,
+	})
+}
+
 type ConfigRuleEvaluator struct {
 	configStore          ConfigStoreGetter
 	projectEnvIDSupplier ProjectEnvIDSupplier
@@ -35,6 +76,12 @@ func NewConfigRuleEvaluator(configStore ConfigStoreGetter, projectEnvIDSupplier
 }
 
 func (cve *ConfigRuleEvaluator) EvaluateConfig(config *prefabProto.Config, contextSet ContextValueGetter) ConditionMatch {
+	return cve.EvaluateConfigWithTrace(config, contextSet, nil)
+}
+
+// EvaluateConfigWithTrace is EvaluateConfig, recording each criterion it evaluates in trace.
+// Criteria of segments referenced with IN_SEG/NOT_IN_SEG are not recorded individually.
+func (cve *ConfigRuleEvaluator) EvaluateConfigWithTrace(config *prefabProto.Config, contextSet ContextValueGetter, trace *EvaluationTrace) ConditionMatch {
 	// find the right row for the env id, then the no-env id row
 	// iterate over conditional values in rows
 	// evaluate criterion
@@ -44,7 +91,7 @@ func (cve *ConfigRuleEvaluator) EvaluateConfig(config *prefabProto.Config, conte
 	if envRowExists {
 		noEnvRowIndex = 1
 
-		match := cve.EvaluateRow(envRow, contextSet, 0)
+		match := cve.evaluateRow(envRow, contextSet, 0, trace)
 		if match.IsMatch {
 			return match
 		}
@@ -52,7 +99,7 @@ func (cve *ConfigRuleEvaluator) EvaluateConfig(config *prefabProto.Config, conte
 
 	noEnvRow, noEnvRowExists := rowWithoutEnvID(config)
 	if noEnvRowExists {
-		match := cve.EvaluateRow(noEnvRow, contextSet, noEnvRowIndex)
+		match := cve.evaluateRow(noEnvRow, contextSet, noEnvRowIndex, trace)
 		if match.IsMatch {
 			return match
 		}
@@ -62,11 +109,17 @@ func (cve *ConfigRuleEvaluator) EvaluateConfig(config *prefabProto.Config, conte
 }
 
 func (cve *ConfigRuleEvaluator) EvaluateRow(row *prefabProto.ConfigRow, contextSet ContextValueGetter, rowIndex int) ConditionMatch {
+	return cve.evaluateRow(row, contextSet, rowIndex, nil)
+}
+
+func (cve *ConfigRuleEvaluator) evaluateRow(row *prefabProto.ConfigRow, contextSet ContextValueGetter, rowIndex int, trace *EvaluationTrace) ConditionMatch {
 	conditionMatch := ConditionMatch{}
 	conditionMatch.IsMatch = false
 
 	for conditionalValueIndex, conditionalValue := range row.GetValues() {
-		matchedValue, matched := cve.EvaluateConditionalValue(conditionalValue, contextSet)
+		trace.at(rowIndex, conditionalValueIndex)
+
+		matchedValue, matched := cve.evaluateConditionalValue(conditionalValue, contextSet, trace)
 		if matched {
 			conditionMatch.IsMatch = true
 			conditionMatch.RowIndex = &rowIndex
@@ -82,8 +135,12 @@ func (cve *ConfigRuleEvaluator) EvaluateRow(row *prefabProto.ConfigRow, contextS
 }
 
 func (cve *ConfigRuleEvaluator) EvaluateConditionalValue(conditionalValue *prefabProto.ConditionalValue, contextSet ContextValueGetter) (*prefabProto.ConfigValue, bool) {
+	return cve.evaluateConditionalValue(conditionalValue, contextSet, nil)
+}
+
+func (cve *ConfigRuleEvaluator) evaluateConditionalValue(conditionalValue *prefabProto.ConditionalValue, contextSet ContextValueGetter, trace *EvaluationTrace) (*prefabProto.ConfigValue, bool) {
 	for _, criterion := range conditionalValue.GetCriteria() {
-		if !cve.EvaluateCriterion(criterion, contextSet) {
+		if !cve.evaluateCriterion(criterion, contextSet, trace) {
 			return nil, false
 		}
 	}
@@ -121,6 +178,10 @@ func contextValueToStringSlice(contextValue interface{}) []string {
 }
 
 func (cve *ConfigRuleEvaluator) EvaluateCriterion(criterion *prefabProto.Criterion, contextSet ContextValueGetter) bool {
+	return cve.evaluateCriterion(criterion, contextSet, nil)
+}
+
+func (cve *ConfigRuleEvaluator) evaluateCriterion(criterion *prefabProto.Criterion, contextSet ContextValueGetter, trace *EvaluationTrace) bool {
 	// get the value from context
 	contextValue, contextValueExists := contextSet.GetContextValue(criterion.GetPropertyName())
 
@@ -132,6 +193,13 @@ func (cve *ConfigRuleEvaluator) EvaluateCriterion(criterion *prefabProto.Criteri
 		contextValueExists = true
 	}
 
+	matched := cve.matchCriterion(criterion, contextValue, contextValueExists, contextSet)
+	trace.record(criterion, contextValue, contextValueExists, matched)
+
+	return matched
+}
+
+func (cve *ConfigRuleEvaluator) matchCriterion(criterion *prefabProto.Criterion, contextValue any, contextValueExists bool, contextSet ContextValueGetter) bool {
 	matchValue, _, err := utils.ExtractValue(criterion.GetValueToMatch())
 
 	switch criterion.GetOperator() {
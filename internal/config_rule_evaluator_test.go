@@ -804,6 +804,44 @@ func (suite *ConfigRuleTestSuite) setupMockConfigStoreGetter(configKey string, c
 	return cleanupFunc
 }
 
+func (suite *ConfigRuleTestSuite) TestEvaluateConfigWithTraceRecordsCriteria() {
+	emailCriterion := &prefabProto.Criterion{
+		Operator:     prefabProto.Criterion_PROP_ENDS_WITH_ONE_OF,
+		ValueToMatch: testutils.CreateConfigValueAndAssertOk(suite.T(), []string{"example.com"}),
+		PropertyName: "user.email",
+	}
+	planCriterion := &prefabProto.Criterion{
+		Operator:     prefabProto.Criterion_PROP_IS_ONE_OF,
+		ValueToMatch: testutils.CreateConfigValueAndAssertOk(suite.T(), []string{"pro"}),
+		PropertyName: "user.plan",
+	}
+	config := &prefabProto.Config{
+		Rows: []*prefabProto.ConfigRow{{
+			Values: []*prefabProto.ConditionalValue{
+				{Criteria: []*prefabProto.Criterion{emailCriterion}, Value: testutils.CreateConfigValueAndAssertOk(suite.T(), "internal")},
+				{Criteria: []*prefabProto.Criterion{planCriterion}, Value: testutils.CreateConfigValueAndAssertOk(suite.T(), "pro")},
+				{Value: testutils.CreateConfigValueAndAssertOk(suite.T(), "fallback")},
+			},
+		}},
+	}
+
+	mockContext, assertMockCalled := suite.setupMockContextWithMultipleValues([]ContextMocking{
+		{contextPropertyName: "user.email", value: "me@customer.com", exists: true},
+		{contextPropertyName: "user.plan", value: "pro", exists: true},
+	})
+	defer assertMockCalled()
+
+	trace := &internal.EvaluationTrace{}
+	match := suite.evaluator.EvaluateConfigWithTrace(config, mockContext, trace)
+
+	suite.True(match.IsMatch)
+	suite.Equal("pro", match.Match.GetString_())
+	suite.Equal([]internal.CriterionEvaluation{
+		{Criterion: emailCriterion, ContextValue: "me@customer.com", RowIndex: 0, ConditionalValueIndex: 0, ContextValueExists: true, Matched: false},
+		{Criterion: planCriterion, ContextValue: "pro", RowIndex: 0, ConditionalValueIndex: 1, ContextValueExists: true, Matched: true},
+	}, trace.Criteria)
+}
+
 func (suite *ConfigRuleTestSuite) TestCurrentTimeProperty() {
 	// Create a criterion with the "prefab.current-time" property
 	criterion := &prefabProto.Criterion{
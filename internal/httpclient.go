@@ -16,6 +16,7 @@ import (
 
 type HTTPClient struct {
 	Options *options.Options
+	client  *http.Client
 	URLs    []string
 }
 
@@ -25,7 +26,7 @@ func BuildHTTPClient(options options.Options) (*HTTPClient, error) {
 		return nil, err
 	}
 
-	client := HTTPClient{Options: &options, URLs: apiURLs}
+	client := HTTPClient{Options: &options, client: options.RequestHTTPClient(), URLs: apiURLs}
 
 	return &client, nil
 }
@@ -61,10 +62,11 @@ func (c *HTTPClient) LoadFromURI(uri string, sdkKey string, offset int64) (*pref
 		return nil, err
 	}
 
+	c.Options.SetHTTPHeaders(req.Header)
 	req.SetBasicAuth("1", sdkKey)
-	req.Header.Add("X-Reforge-SDK-Version", ClientVersionHeader)
+	req.Header.Set("X-Reforge-SDK-Version", ClientVersionHeader)
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := c.client.Do(req)
 	if err != nil {
 		return nil, err
 	}
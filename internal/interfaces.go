@@ -24,10 +24,22 @@ type ConfigStoreGetter = interface {
 	ProjectEnvIDSupplier
 }
 
+// ContextualConfigStoreGetter is implemented by stores whose configs can depend
+// on the context being evaluated. GetConfig returns only the configs that apply
+// to every context.
+type ContextualConfigStoreGetter interface {
+	GetConfigForContext(key string, contextSet ContextValueGetter) (config *prefabProto.Config, exists bool)
+}
+
 type ConfigEvaluator interface {
 	EvaluateConfig(config *prefabProto.Config, contextSet ContextValueGetter) (match ConditionMatch)
 }
 
+// TracingConfigEvaluator is implemented by evaluators that can report the criteria they evaluated.
+type TracingConfigEvaluator interface {
+	EvaluateConfigWithTrace(config *prefabProto.Config, contextSet ContextValueGetter, trace *EvaluationTrace) (match ConditionMatch)
+}
+
 type Decrypter interface {
 	DecryptValue(secretKey string, value string) (decryptedValue string, err error)
 }
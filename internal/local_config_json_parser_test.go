@@ -0,0 +1,36 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ReforgeHQ/sdk-go/internal"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+func TestLocalConfigJSONParserBytesAndIntRange(t *testing.T) {
+	jsonInput := `{
+		"configServicePointer": {"projectEnvId": "7"},
+		"configs": [
+			{"key": "pins.leaf", "valueType": "BYTES", "rows": [{"values": [{"value": {"bytes": "3q2+7w=="}}]}]},
+			{"key": "ranges.adults", "valueType": "INT_RANGE", "rows": [{"values": [{"value": {"intRange": {"start": "18", "end": "65"}}}]}]}
+		]
+	}`
+
+	p := &internal.LocalConfigJSONParser{}
+
+	configs, projectEnvID, err := p.Parse([]byte(jsonInput))
+	require.NoError(t, err)
+	require.Len(t, configs, 2)
+	assert.Equal(t, int64(7), projectEnvID)
+
+	assert.Equal(t, prefabProto.Config_BYTES, configs[0].GetValueType())
+	assert.Equal(t, []byte{0xde, 0xad, 0xbe, 0xef}, configs[0].GetRows()[0].GetValues()[0].GetValue().GetBytes())
+
+	intRange := configs[1].GetRows()[0].GetValues()[0].GetValue().GetIntRange()
+	assert.Equal(t, prefabProto.Config_INT_RANGE, configs[1].GetValueType())
+	assert.Equal(t, int64(18), intRange.GetStart())
+	assert.Equal(t, int64(65), intRange.GetEnd())
+}
@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -12,12 +13,30 @@ import (
 	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
 )
 
+// Tags for values whose type is lost when decoding YAML into interface{}
+const (
+	yamlBinaryTag   = "!!binary"
+	yamlIntRangeTag = "!int_range"
+)
+
 type LocalConfigYamlParser struct{}
 
 func (p *LocalConfigYamlParser) Parse(yamlData []byte) ([]*prefabProto.Config, int64, error) {
+	var document yaml.Node
+
+	err := yaml.Unmarshal(yamlData, &document)
+	if err != nil {
+		return nil, 0, err
+	}
+
 	var data map[string]interface{}
-	// Unmarshal the YAML into the map
-	err := yaml.Unmarshal(yamlData, &data)
+	// Decode the YAML into the map
+	err = decodeTypedYAML(&document, &data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	projectEnvID, err := takeProjectEnvID(data)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -33,7 +52,7 @@ func (p *LocalConfigYamlParser) Parse(yamlData []byte) ([]*prefabProto.Config, i
 		outputValues = append(outputValues, configValues...)
 	}
 
-	return outputValues, 0, nil
+	return outputValues, projectEnvID, nil
 }
 
 func (p *LocalConfigYamlParser) handleMapKeyValue(keyPath []string, mapKey string, mapValue interface{}) ([]*prefabProto.Config, error) {
@@ -42,6 +61,22 @@ func (p *LocalConfigYamlParser) handleMapKeyValue(keyPath []string, mapKey strin
 	switch value := mapValue.(type) {
 	case map[string]interface{}:
 		{
+			fullKeyName := strings.Join(append(keyPath, mapKey), ".")
+
+			isRules, err := isRuleConfig(fullKeyName, value)
+			if err != nil {
+				return nil, err
+			}
+
+			if isRules {
+				newConfig, err := p.createRuleConfig(fullKeyName, value)
+				if err != nil {
+					return nil, err
+				}
+
+				return []*prefabProto.Config{newConfig}, nil
+			}
+
 			featureFlagValue, featureFlagKeyExists := value["feature_flag"]
 			if featureFlagKeyExists {
 				isFeatureFlag, parsingWorked := p.coerceToBool(featureFlagValue)
@@ -70,7 +105,6 @@ func (p *LocalConfigYamlParser) handleMapKeyValue(keyPath []string, mapKey strin
 			var accumulatedConfigValues []*prefabProto.Config
 
 			if underscoreValue, underscoreExists := value["_"]; underscoreExists {
-				fullKeyName := strings.Join(append(keyPath, mapKey), ".")
 				configValue, ok := p.createConfig(fullKeyName, underscoreValue, configType)
 
 				if !ok {
@@ -120,11 +154,33 @@ func (p *LocalConfigYamlParser) coerceToBool(maybeBool interface{}) (bool, bool)
 }
 
 func (p *LocalConfigYamlParser) createConfig(key string, value any, configType prefabProto.ConfigType) (*prefabProto.Config, bool) {
-	var configValue *prefabProto.ConfigValue
-
-	if key == "log-level" || strings.HasPrefix(key, "log-level") {
+	if isLogLevelKey(key) {
 		configType = prefabProto.ConfigType_LOG_LEVEL
+	}
+
+	configValue, ok := p.createConfigValue(key, value)
+	if !ok {
+		return nil, false
+	}
+
+	row := &prefabProto.ConfigRow{
+		Values: []*prefabProto.ConditionalValue{{Value: configValue}},
+	}
+
+	valueType := utils.GetValueType(configValue)
+
+	return &prefabProto.Config{Key: key, Rows: []*prefabProto.ConfigRow{row}, ValueType: valueType, ConfigType: configType}, true
+}
+
+func isLogLevelKey(key string) bool {
+	return key == "log-level" || strings.HasPrefix(key, "log-level")
+}
 
+// createConfigValue creates the value for key, reading strings as log levels for log-level keys
+func (p *LocalConfigYamlParser) createConfigValue(key string, value any) (*prefabProto.ConfigValue, bool) {
+	var configValue *prefabProto.ConfigValue
+
+	if isLogLevelKey(key) {
 		switch v := value.(type) {
 		case string:
 			logLevel, ok := prefabProto.LogLevel_value[strings.ToUpper(v)]
@@ -157,11 +213,134 @@ func (p *LocalConfigYamlParser) createConfig(key string, value any, configType p
 		}
 	}
 
-	row := &prefabProto.ConfigRow{
-		Values: []*prefabProto.ConditionalValue{{Value: configValue}},
+	return configValue, true
+}
+
+// decodeTypedYAML decodes node into data like node.Decode, except that
+// !!binary values become []byte instead of strings and !int_range values
+// become IntRanges. Merge keys are only supported in mappings
+// without such values.
+func decodeTypedYAML(node *yaml.Node, data *map[string]interface{}) error {
+	if node.Kind == 0 || !hasTypedYAMLValue(node) {
+		return node.Decode(data)
 	}
 
-	valueType := utils.GetValueType(configValue)
+	value, err := typedYAMLValue(node)
+	if err != nil {
+		return err
+	}
 
-	return &prefabProto.Config{Key: key, Rows: []*prefabProto.ConfigRow{row}, ValueType: valueType, ConfigType: configType}, true
+	mapping, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("yaml must be a mapping, not %T", value)
+	}
+
+	*data = mapping
+
+	return nil
+}
+
+func hasTypedYAMLValue(node *yaml.Node) bool {
+	switch {
+	case node.Tag == yamlIntRangeTag:
+		return true
+	case node.Kind == yaml.ScalarNode:
+		return node.ShortTag() == yamlBinaryTag
+	case node.Kind == yaml.AliasNode:
+		return hasTypedYAMLValue(node.Alias)
+	default:
+		for _, child := range node.Content {
+			if hasTypedYAMLValue(child) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+func typedYAMLValue(node *yaml.Node) (interface{}, error) {
+	if node.Tag == yamlIntRangeTag {
+		return intRangeFromYAML(node)
+	}
+
+	if !hasTypedYAMLValue(node) {
+		var value interface{}
+		err := node.Decode(&value)
+
+		return value, err
+	}
+
+	switch node.Kind {
+	case yaml.DocumentNode:
+		return typedYAMLValue(node.Content[0])
+	case yaml.AliasNode:
+		return typedYAMLValue(node.Alias)
+	case yaml.SequenceNode:
+		values := make([]interface{}, 0, len(node.Content))
+
+		for _, child := range node.Content {
+			value, err := typedYAMLValue(child)
+			if err != nil {
+				return nil, err
+			}
+
+			values = append(values, value)
+		}
+
+		return values, nil
+	case yaml.MappingNode:
+		values := make(map[string]interface{}, len(node.Content)/2)
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			var key string
+			if err := node.Content[i].Decode(&key); err != nil {
+				return nil, err
+			}
+
+			value, err := typedYAMLValue(node.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+
+			values[key] = value
+		}
+
+		return values, nil
+	}
+
+	// Long binary values are usually folded over several lines
+	decoded, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(node.Value), ""))
+	if err != nil {
+		return nil, fmt.Errorf("line %d: invalid !!binary value: %w", node.Line, err)
+	}
+
+	return decoded, nil
+}
+
+// intRangeFromYAML reads an !int_range value, written either as "start..end"
+// or like an IN_INT_RANGE criterion as {start: 18, end: 65}.
+func intRangeFromYAML(node *yaml.Node) (*prefabProto.IntRange, error) {
+	var (
+		intRange *prefabProto.IntRange
+		err      error
+	)
+
+	switch node.Kind {
+	case yaml.ScalarNode:
+		intRange, err = utils.ParseIntRange(node.Value)
+	case yaml.MappingNode:
+		var bounds map[string]interface{}
+		if err = node.Decode(&bounds); err == nil {
+			intRange, err = intRangeFromMap(bounds)
+		}
+	default:
+		err = errors.New("!int_range must be a string or a mapping")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("line %d: %w", node.Line, err)
+	}
+
+	return intRange, nil
 }
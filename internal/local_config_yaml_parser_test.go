@@ -100,6 +100,32 @@ log-level:
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "binary and int ranges",
+			yamlInput: `
+pins:
+  leaf: !!binary 3q2+7w==
+  chain: !!binary |
+    3q2+
+    7w==
+ranges:
+  adults: !int_range 18..65
+  open: !int_range "..0"
+  mapping: !int_range {start: 1}`,
+			wantConfigs: []*prefabProto.Config{
+				s.createConfig("pins.leaf", testutils.CreateConfigValueAndAssertOk(s.T(), []byte{0xde, 0xad, 0xbe, 0xef}), prefabProto.ConfigType_CONFIG, prefabProto.Config_BYTES),
+				s.createConfig("pins.chain", testutils.CreateConfigValueAndAssertOk(s.T(), []byte{0xde, 0xad, 0xbe, 0xef}), prefabProto.ConfigType_CONFIG, prefabProto.Config_BYTES),
+				s.createConfig("ranges.adults", testutils.CreateConfigValueAndAssertOk(s.T(), &prefabProto.IntRange{Start: internal.Int64Ptr(18), End: internal.Int64Ptr(65)}), prefabProto.ConfigType_CONFIG, prefabProto.Config_INT_RANGE),
+				s.createConfig("ranges.open", testutils.CreateConfigValueAndAssertOk(s.T(), &prefabProto.IntRange{End: internal.Int64Ptr(0)}), prefabProto.ConfigType_CONFIG, prefabProto.Config_INT_RANGE),
+				s.createConfig("ranges.mapping", testutils.CreateConfigValueAndAssertOk(s.T(), &prefabProto.IntRange{Start: internal.Int64Ptr(1)}), prefabProto.ConfigType_CONFIG, prefabProto.Config_INT_RANGE),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:      "invalid int range",
+			yamlInput: "ranges:\n  adults: !int_range 18-65",
+			wantErr:   assert.Error,
+		},
 	}
 
 	for _, testCase := range tests {
@@ -118,6 +144,112 @@ log-level:
 	}
 }
 
+func (s *LocalConfigYamlParserTestSuite) TestParseRules() {
+	yamlInput := `
+_project_env_id: 3
+flag:
+  _type: rules
+  feature_flag: true
+  rules:
+    - criteria:
+        - property: user.age
+          operator: in_int_range
+          values: {start: 18, end: 65}
+      rollout:
+        hash_by: user.key
+        values:
+          - {weight: 1, value: true}
+          - {weight: 3, value: false}
+    - value: false
+  environments:
+    - project_env_id: 3
+      rules:
+        - value: true
+beta:
+  _type: rules
+  segment:
+    - criteria:
+        - operator: IN_SEG
+          values: other-segment
+`
+
+	p := &internal.LocalConfigYamlParser{}
+
+	configs, projectEnvID, err := p.Parse([]byte(yamlInput))
+	s.Require().NoError(err)
+	s.Equal(int64(3), projectEnvID)
+	s.Require().Len(configs, 2)
+
+	configsByKey := map[string]*prefabProto.Config{}
+	for _, config := range configs {
+		configsByKey[config.GetKey()] = config
+	}
+
+	flag := configsByKey["flag"]
+	s.Equal(prefabProto.ConfigType_FEATURE_FLAG, flag.GetConfigType())
+	s.Equal(prefabProto.Config_BOOL, flag.GetValueType())
+	s.Require().Len(flag.GetRows(), 2)
+	s.Nil(flag.GetRows()[0].ProjectEnvId)
+	s.Equal(int64(3), flag.GetRows()[1].GetProjectEnvId())
+
+	firstRule := flag.GetRows()[0].GetValues()[0]
+	s.Equal(prefabProto.Criterion_IN_INT_RANGE, firstRule.GetCriteria()[0].GetOperator())
+	s.Equal(int64(18), firstRule.GetCriteria()[0].GetValueToMatch().GetIntRange().GetStart())
+	s.Equal(int64(65), firstRule.GetCriteria()[0].GetValueToMatch().GetIntRange().GetEnd())
+	s.Equal("user.key", firstRule.GetValue().GetWeightedValues().GetHashByPropertyName())
+	s.Len(firstRule.GetValue().GetWeightedValues().GetWeightedValues(), 2)
+
+	s.Run("rule values can be typed", func() {
+		configs, _, err := p.Parse([]byte("tiers:\n  _type: rules\n  rules:\n    - criteria: [{property: user.plan, operator: PROP_IS_ONE_OF, values: [pro]}]\n      value: !int_range 0..1000\n    - value: !int_range 0..10\n"))
+		s.Require().NoError(err)
+		s.Require().Len(configs, 1)
+		s.Equal(prefabProto.Config_INT_RANGE, configs[0].GetValueType())
+		s.Equal(int64(1000), configs[0].GetRows()[0].GetValues()[0].GetValue().GetIntRange().GetEnd())
+	})
+
+	s.Run("rules need the _type marker", func() {
+		configs, _, err := p.Parse([]byte("limits:\n  rules: [strict, lenient]\n"))
+		s.Require().NoError(err)
+		s.Require().Len(configs, 1)
+		s.Equal("limits.rules", configs[0].GetKey())
+		s.Equal([]string{"strict", "lenient"}, configs[0].GetRows()[0].GetValues()[0].GetValue().GetStringList().GetValues())
+	})
+
+	segment := configsByKey["beta"]
+	s.Equal(prefabProto.ConfigType_SEGMENT, segment.GetConfigType())
+	s.Require().Len(segment.GetRows()[0].GetValues(), 2)
+	s.True(segment.GetRows()[0].GetValues()[0].GetValue().GetBool())
+	s.Equal("other-segment", segment.GetRows()[0].GetValues()[0].GetCriteria()[0].GetValueToMatch().GetString_())
+	s.False(segment.GetRows()[0].GetValues()[1].GetValue().GetBool())
+	s.Empty(segment.GetRows()[0].GetValues()[1].GetCriteria())
+}
+
+func (s *LocalConfigYamlParserTestSuite) TestParseRulesRejectsInvalidRules() {
+	tests := []struct {
+		name      string
+		yamlInput string
+		errorText string
+	}{
+		{name: "unknown operator", yamlInput: "a:\n  _type: rules\n  rules:\n    - criteria: [{property: x, operator: NOPE, values: [1]}]\n      value: 1\n", errorText: `unknown operator "NOPE"`},
+		{name: "value and rollout", yamlInput: "a:\n  _type: rules\n  rules:\n    - value: 1\n      rollout: {values: [{weight: 1, value: 2}]}\n", errorText: "exactly one of value or rollout"},
+		{name: "misspelled key", yamlInput: "a:\n  _type: rules\n  rules:\n    - critera: []\n      value: 1\n", errorText: "unknown keys critera"},
+		{name: "zero weights", yamlInput: "a:\n  _type: rules\n  rules:\n    - rollout: {values: [{weight: 0, value: 2}]}\n", errorText: "must not all be zero"},
+		{name: "missing property", yamlInput: "a:\n  _type: rules\n  rules:\n    - criteria: [{operator: PROP_IS_ONE_OF, values: [b]}]\n      value: 1\n", errorText: "property is required"},
+		{name: "segment with a value", yamlInput: "a:\n  _type: rules\n  segment:\n    - value: true\n", errorText: "segment rules can't have a value"},
+		{name: "unknown type", yamlInput: "a:\n  _type: rule\n  rules:\n    - value: 1\n", errorText: `a: unknown _type rule, expected "rules"`},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			p := &internal.LocalConfigYamlParser{}
+
+			_, _, err := p.Parse([]byte(tt.yamlInput))
+			s.Require().Error(err)
+			s.Contains(err.Error(), tt.errorText)
+		})
+	}
+}
+
 // TestLocalConfigYamlParserTestSuite runs the test suite.
 func TestLocalConfigYamlParserTestSuite(t *testing.T) {
 	suite.Run(t, new(LocalConfigYamlParserTestSuite))
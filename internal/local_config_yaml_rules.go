@@ -0,0 +1,470 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/ReforgeHQ/sdk-go/internal/utils"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+// Rule configs in YAML datafiles are marked with `_type: rules` and compile to
+// the same rows the API serves:
+//
+//	_project_env_id: 5 # optional, selects the environments entry below
+//
+//	checkout.new-flow:
+//	  _type: rules
+//	  feature_flag: true
+//	  rules: # evaluated in order, the first match wins
+//	    - criteria: # all must match; omit to always match
+//	        - property: user.email
+//	          operator: PROP_ENDS_WITH_ONE_OF
+//	          values: ["@example.com"]
+//	      value: true
+//	    - criteria:
+//	        - operator: IN_SEG
+//	          values: beta-users
+//	      rollout:
+//	        hash_by: user.key
+//	        values:
+//	          - weight: 10
+//	            value: true
+//	          - weight: 90
+//	            value: false
+//	    - value: false
+//	  environments: # checked before rules in the given project env
+//	    - project_env_id: 5
+//	      rules:
+//	        - criteria:
+//	            - property: user.plan
+//	              operator: PROP_IS_ONE_OF
+//	              values: [enterprise]
+//	          value: true
+//
+//	beta-users:
+//	  _type: rules
+//	  segment: # in the segment if any rule matches
+//	    - criteria:
+//	        - property: user.plan
+//	          operator: PROP_IS_ONE_OF
+//	          values: [beta, internal]
+//
+// Operators are the Criterion_CriterionOperator names. values is a list for
+// the *_ONE_OF operators, a segment key for IN_SEG and NOT_IN_SEG, a map with
+// start and/or end for IN_INT_RANGE, and a single value otherwise.
+//
+// Without the marker a map is read as nested keys, even if it has a rules or
+// segment list.
+
+const (
+	projectEnvIDKey = "_project_env_id"
+	configTypeKey   = "_type"
+	ruleConfigType  = "rules"
+)
+
+var (
+	ruleConfigKeys  = map[string]bool{configTypeKey: true, "rules": true, "segment": true, "feature_flag": true, "environments": true}
+	ruleKeys        = map[string]bool{"criteria": true, "value": true, "rollout": true}
+	criterionKeys   = map[string]bool{"property": true, "operator": true, "values": true}
+	environmentKeys = map[string]bool{"project_env_id": true, "rules": true}
+)
+
+// takeProjectEnvID removes the top level _project_env_id from data and returns it.
+func takeProjectEnvID(data map[string]interface{}) (int64, error) {
+	value, exists := data[projectEnvIDKey]
+	if !exists {
+		return 0, nil
+	}
+
+	delete(data, projectEnvIDKey)
+
+	projectEnvID, ok := value.(int)
+	if !ok {
+		return 0, fmt.Errorf("%s must be an integer, got %v", projectEnvIDKey, value)
+	}
+
+	return int64(projectEnvID), nil
+}
+
+// isRuleConfig reports whether value is marked with `_type: rules`. Any other _type is an error, so a
+// misspelled marker isn't read as nested keys.
+func isRuleConfig(key string, value map[string]interface{}) (bool, error) {
+	configType, exists := value[configTypeKey]
+	if !exists {
+		return false, nil
+	}
+
+	if configType != ruleConfigType {
+		return false, fmt.Errorf("%s: unknown %s %v, expected %q", key, configTypeKey, configType, ruleConfigType)
+	}
+
+	return true, nil
+}
+
+func (p *LocalConfigYamlParser) createRuleConfig(key string, value map[string]interface{}) (*prefabProto.Config, error) {
+	if err := checkKeys(key, value, ruleConfigKeys); err != nil {
+		return nil, err
+	}
+
+	rulesKey := "rules"
+	configType := prefabProto.ConfigType_CONFIG
+
+	_, isSegment := value["segment"]
+
+	switch {
+	case isSegment && value["rules"] != nil:
+		return nil, fmt.Errorf("%s: a config can't have both rules and segment", key)
+	case isSegment:
+		rulesKey = "segment"
+		configType = prefabProto.ConfigType_SEGMENT
+	case isLogLevelKey(key):
+		configType = prefabProto.ConfigType_LOG_LEVEL
+	default:
+		if isFeatureFlag, ok := p.coerceToBool(value["feature_flag"]); ok && isFeatureFlag {
+			configType = prefabProto.ConfigType_FEATURE_FLAG
+		}
+	}
+
+	defaultRow, err := p.compileRules(key, value[rulesKey], isSegment)
+	if err != nil {
+		return nil, err
+	}
+
+	if isSegment {
+		defaultRow.Values = append(defaultRow.Values, &prefabProto.ConditionalValue{Value: boolValue(false)})
+	}
+
+	rows := []*prefabProto.ConfigRow{defaultRow}
+
+	if environments, exists := value["environments"]; exists {
+		environmentRows, err := p.compileEnvironments(key, environments, isSegment)
+		if err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, environmentRows...)
+	}
+
+	return &prefabProto.Config{Key: key, Rows: rows, ValueType: rowsValueType(rows), ConfigType: configType}, nil
+}
+
+func (p *LocalConfigYamlParser) compileEnvironments(key string, environments interface{}, isSegment bool) ([]*prefabProto.ConfigRow, error) {
+	environmentList, ok := environments.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: environments must be a list", key)
+	}
+
+	rows := make([]*prefabProto.ConfigRow, 0, len(environmentList))
+
+	for index, environment := range environmentList {
+		environmentKey := fmt.Sprintf("%s environments[%d]", key, index)
+
+		environmentMap, ok := environment.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s: must be a map", environmentKey)
+		}
+
+		if err := checkKeys(environmentKey, environmentMap, environmentKeys); err != nil {
+			return nil, err
+		}
+
+		projectEnvID, ok := environmentMap["project_env_id"].(int)
+		if !ok {
+			return nil, fmt.Errorf("%s: project_env_id must be an integer", environmentKey)
+		}
+
+		row, err := p.compileRules(environmentKey, environmentMap["rules"], isSegment)
+		if err != nil {
+			return nil, err
+		}
+
+		envID := int64(projectEnvID)
+		row.ProjectEnvId = &envID
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func (p *LocalConfigYamlParser) compileRules(key string, rules interface{}, isSegment bool) (*prefabProto.ConfigRow, error) {
+	ruleList, ok := rules.([]interface{})
+	if !ok || len(ruleList) == 0 {
+		return nil, fmt.Errorf("%s: rules must be a non-empty list", key)
+	}
+
+	row := &prefabProto.ConfigRow{}
+
+	for index, rule := range ruleList {
+		ruleKey := fmt.Sprintf("%s rule %d", key, index+1)
+
+		ruleMap, ok := rule.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s: must be a map", ruleKey)
+		}
+
+		conditionalValue, err := p.compileRule(ruleKey, key, ruleMap, isSegment)
+		if err != nil {
+			return nil, err
+		}
+
+		row.Values = append(row.Values, conditionalValue)
+	}
+
+	return row, nil
+}
+
+func (p *LocalConfigYamlParser) compileRule(ruleKey string, configKey string, rule map[string]interface{}, isSegment bool) (*prefabProto.ConditionalValue, error) {
+	if err := checkKeys(ruleKey, rule, ruleKeys); err != nil {
+		return nil, err
+	}
+
+	conditionalValue := &prefabProto.ConditionalValue{}
+
+	if criteria, exists := rule["criteria"]; exists {
+		criteriaList, ok := criteria.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s: criteria must be a list", ruleKey)
+		}
+
+		for index, criterion := range criteriaList {
+			compiled, err := compileCriterion(fmt.Sprintf("%s criterion %d", ruleKey, index+1), criterion)
+			if err != nil {
+				return nil, err
+			}
+
+			conditionalValue.Criteria = append(conditionalValue.Criteria, compiled)
+		}
+	}
+
+	value, hasValue := rule["value"]
+	rollout, hasRollout := rule["rollout"]
+
+	switch {
+	case isSegment && (hasValue || hasRollout):
+		return nil, fmt.Errorf("%s: segment rules can't have a value or rollout", ruleKey)
+	case isSegment:
+		conditionalValue.Value = boolValue(true)
+	case hasValue == hasRollout:
+		return nil, fmt.Errorf("%s: must have exactly one of value or rollout", ruleKey)
+	case hasValue:
+		configValue, ok := p.createConfigValue(configKey, value)
+		if !ok {
+			return nil, fmt.Errorf("%s: unable to create value from %v", ruleKey, value)
+		}
+
+		conditionalValue.Value = configValue
+	default:
+		weightedValues, err := p.compileRollout(ruleKey, configKey, rollout)
+		if err != nil {
+			return nil, err
+		}
+
+		conditionalValue.Value = &prefabProto.ConfigValue{Type: &prefabProto.ConfigValue_WeightedValues{WeightedValues: weightedValues}}
+	}
+
+	return conditionalValue, nil
+}
+
+func (p *LocalConfigYamlParser) compileRollout(ruleKey string, configKey string, rollout interface{}) (*prefabProto.WeightedValues, error) {
+	rolloutMap, ok := rollout.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: rollout must be a map", ruleKey)
+	}
+
+	if err := checkKeys(ruleKey+" rollout", rolloutMap, map[string]bool{"hash_by": true, "values": true}); err != nil {
+		return nil, err
+	}
+
+	weightedValues := &prefabProto.WeightedValues{}
+
+	if hashBy, exists := rolloutMap["hash_by"]; exists {
+		hashByProperty, ok := hashBy.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s: rollout hash_by must be a string", ruleKey)
+		}
+
+		weightedValues.HashByPropertyName = &hashByProperty
+	}
+
+	values, ok := rolloutMap["values"].([]interface{})
+	if !ok || len(values) == 0 {
+		return nil, fmt.Errorf("%s: rollout values must be a non-empty list", ruleKey)
+	}
+
+	totalWeight := 0
+
+	for index, value := range values {
+		valueKey := fmt.Sprintf("%s rollout value %d", ruleKey, index+1)
+
+		valueMap, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s: must be a map with weight and value", valueKey)
+		}
+
+		if err := checkKeys(valueKey, valueMap, map[string]bool{"weight": true, "value": true}); err != nil {
+			return nil, err
+		}
+
+		weight, ok := valueMap["weight"].(int)
+		if !ok || weight < 0 || weight > math.MaxInt32 {
+			return nil, fmt.Errorf("%s: weight must be a non-negative integer", valueKey)
+		}
+
+		configValue, ok := p.createConfigValue(configKey, valueMap["value"])
+		if !ok {
+			return nil, fmt.Errorf("%s: unable to create value from %v", valueKey, valueMap["value"])
+		}
+
+		totalWeight += weight
+
+		weightedValues.WeightedValues = append(weightedValues.WeightedValues, &prefabProto.WeightedValue{Weight: int32(weight), Value: configValue})
+	}
+
+	if totalWeight == 0 {
+		return nil, fmt.Errorf("%s: rollout weights must not all be zero", ruleKey)
+	}
+
+	return weightedValues, nil
+}
+
+func compileCriterion(criterionKey string, criterion interface{}) (*prefabProto.Criterion, error) {
+	criterionMap, ok := criterion.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: must be a map", criterionKey)
+	}
+
+	if err := checkKeys(criterionKey, criterionMap, criterionKeys); err != nil {
+		return nil, err
+	}
+
+	operatorName, _ := criterionMap["operator"].(string)
+
+	operatorValue, ok := prefabProto.Criterion_CriterionOperator_value[strings.ToUpper(operatorName)]
+	if !ok || operatorValue == int32(prefabProto.Criterion_NOT_SET) {
+		return nil, fmt.Errorf("%s: unknown operator %q", criterionKey, operatorName)
+	}
+
+	operator := prefabProto.Criterion_CriterionOperator(operatorValue)
+	compiled := &prefabProto.Criterion{Operator: operator}
+
+	if operator == prefabProto.Criterion_ALWAYS_TRUE {
+		return compiled, nil
+	}
+
+	property, _ := criterionMap["property"].(string)
+	if property == "" && operator != prefabProto.Criterion_IN_SEG && operator != prefabProto.Criterion_NOT_IN_SEG {
+		return nil, fmt.Errorf("%s: property is required for %s", criterionKey, operator)
+	}
+
+	compiled.PropertyName = property
+
+	values, exists := criterionMap["values"]
+	if !exists {
+		return nil, fmt.Errorf("%s: values is required for %s", criterionKey, operator)
+	}
+
+	valueToMatch, err := criterionValue(values)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", criterionKey, err)
+	}
+
+	compiled.ValueToMatch = valueToMatch
+
+	return compiled, nil
+}
+
+func criterionValue(values interface{}) (*prefabProto.ConfigValue, error) {
+	switch v := values.(type) {
+	case []interface{}:
+		stringValues := make([]string, len(v))
+		for index, value := range v {
+			stringValues[index] = fmt.Sprint(value)
+		}
+
+		return &prefabProto.ConfigValue{Type: &prefabProto.ConfigValue_StringList{StringList: &prefabProto.StringList{Values: stringValues}}}, nil
+	case map[string]interface{}:
+		intRange, err := intRangeFromMap(v)
+		if err != nil {
+			return nil, err
+		}
+
+		return &prefabProto.ConfigValue{Type: &prefabProto.ConfigValue_IntRange{IntRange: intRange}}, nil
+	default:
+		configValue, ok := utils.Create(values)
+		if !ok {
+			return nil, fmt.Errorf("unable to create value from %v", values)
+		}
+
+		return configValue, nil
+	}
+}
+
+// intRangeFromMap reads a range written as {start: 18, end: 65}; either bound may be left out.
+func intRangeFromMap(bounds map[string]interface{}) (*prefabProto.IntRange, error) {
+	intRange := &prefabProto.IntRange{}
+
+	for key, bound := range bounds {
+		boundValue, ok := bound.(int)
+		if !ok {
+			return nil, fmt.Errorf("range %s must be an integer", key)
+		}
+
+		boundInt := int64(boundValue)
+
+		switch key {
+		case "start":
+			intRange.Start = &boundInt
+		case "end":
+			intRange.End = &boundInt
+		default:
+			return nil, fmt.Errorf("unknown range key %q, expected start or end", key)
+		}
+	}
+
+	return intRange, nil
+}
+
+func checkKeys(context string, value map[string]interface{}, allowed map[string]bool) error {
+	var unknown []string
+
+	for key := range value {
+		if !allowed[key] {
+			unknown = append(unknown, key)
+		}
+	}
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+
+		return errors.New(context + ": unknown keys " + strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
+
+// rowsValueType is the type of the first concrete value, looking inside rollouts.
+func rowsValueType(rows []*prefabProto.ConfigRow) prefabProto.Config_ValueType {
+	for _, row := range rows {
+		for _, conditionalValue := range row.GetValues() {
+			value := conditionalValue.GetValue()
+			if weighted := value.GetWeightedValues(); weighted != nil && len(weighted.GetWeightedValues()) > 0 {
+				value = weighted.GetWeightedValues()[0].GetValue()
+			}
+
+			if valueType := utils.GetValueType(value); valueType != prefabProto.Config_NOT_SET_VALUE_TYPE {
+				return valueType
+			}
+		}
+	}
+
+	return prefabProto.Config_NOT_SET_VALUE_TYPE
+}
+
+func boolValue(value bool) *prefabProto.ConfigValue {
+	return &prefabProto.ConfigValue{Type: &prefabProto.ConfigValue_Bool{Bool: value}}
+}
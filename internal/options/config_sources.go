@@ -3,23 +3,25 @@ package options
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 type ConfigSource struct {
-	Store   StoreType
-	Raw     string
-	Path    string
-	Default bool
+	Store StoreType
+	Raw   string
+	Path  string
+	// PollingInterval is the interval given in a poll:// source, or zero to use Options.PollingInterval
+	PollingInterval time.Duration
+	Default         bool
 }
 
 type StoreType string
 
 const (
-	APIStore   StoreType = "API"
-	DataFile   StoreType = "DataFile"
-	Memory     StoreType = "Memory"
-	// TODO: Support polling
-	// Poll       StoreType = "Poll"
+	APIStore StoreType = "API"
+	DataFile StoreType = "DataFile"
+	Memory   StoreType = "Memory"
+	Poll     StoreType = "Poll"
 
 	MemoryStoreKey = "memory://configs"
 )
@@ -45,6 +47,19 @@ func ParseConfigSource(rawSource string) (ConfigSource, error) {
 		return ConfigSource{Raw: rawSource, Store: DataFile, Default: false, Path: path}, nil
 	case "memory":
 		return ConfigSource{Raw: rawSource, Store: Memory, Default: false, Path: path}, nil
+	case "poll":
+		source := ConfigSource{Raw: rawSource, Store: Poll, Default: false, Path: path}
+
+		if path != "" {
+			interval, err := time.ParseDuration(path)
+			if err != nil || interval <= 0 {
+				return ConfigSource{}, fmt.Errorf("invalid polling interval in source %s", rawSource)
+			}
+
+			source.PollingInterval = interval
+		}
+
+		return source, nil
 	}
 
 	return ConfigSource{}, fmt.Errorf("unknown protocol %s", protocol)
@@ -2,6 +2,7 @@ package options_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -20,3 +21,25 @@ func TestGetDefaultConfigSources(t *testing.T) {
 		Default: true,
 	}, sources[0])
 }
+
+func TestParseConfigSourcePoll(t *testing.T) {
+	source, err := options.ParseConfigSource("poll://15s")
+	require.NoError(t, err)
+	assert.Equal(t, options.ConfigSource{
+		Store:           options.Poll,
+		Raw:             "poll://15s",
+		Path:            "15s",
+		PollingInterval: 15 * time.Second,
+	}, source)
+
+	source, err = options.ParseConfigSource("poll://")
+	require.NoError(t, err)
+	assert.Equal(t, options.Poll, source.Store)
+	assert.Zero(t, source.PollingInterval)
+
+	_, err = options.ParseConfigSource("poll://often")
+	require.Error(t, err)
+
+	_, err = options.ParseConfigSource("poll://-1s")
+	require.Error(t, err)
+}
@@ -0,0 +1,59 @@
+package options
+
+import (
+	"net/http"
+	"time"
+)
+
+// DefaultHTTPTimeout bounds config fetches and telemetry submissions when
+// neither WithHTTPTimeout nor the custom http.Client sets a timeout.
+const DefaultHTTPTimeout = 10 * time.Second
+
+// RequestHTTPClient returns the client for request/response calls: config
+// fetches and telemetry. It is built from HTTPClient and HTTPTransport, with
+// the request timeout applied.
+func (o *Options) RequestHTTPClient() *http.Client {
+	client := o.baseHTTPClient()
+
+	switch {
+	case o.HTTPTimeout > 0:
+		client.Timeout = o.HTTPTimeout
+	case client.Timeout == 0:
+		client.Timeout = DefaultHTTPTimeout
+	}
+
+	return client
+}
+
+// StreamHTTPClient returns the client for SSE streams. It shares the
+// transport of RequestHTTPClient but has no overall timeout, which would cut
+// off a healthy long-lived stream.
+func (o *Options) StreamHTTPClient() *http.Client {
+	client := o.baseHTTPClient()
+	client.Timeout = 0
+
+	return client
+}
+
+// SetHTTPHeaders adds HTTPHeaders to header. SDK headers should be set
+// afterwards so they can't be overridden.
+func (o *Options) SetHTTPHeaders(header http.Header) {
+	for name, value := range o.HTTPHeaders {
+		header.Set(name, value)
+	}
+}
+
+func (o *Options) baseHTTPClient() *http.Client {
+	client := &http.Client{}
+
+	if o.HTTPClient != nil {
+		copied := *o.HTTPClient
+		client = &copied
+	}
+
+	if o.HTTPTransport != nil {
+		client.Transport = o.HTTPTransport
+	}
+
+	return client
+}
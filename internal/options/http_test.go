@@ -0,0 +1,55 @@
+package options_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ReforgeHQ/sdk-go/internal/options"
+)
+
+func TestRequestAndStreamHTTPClients(t *testing.T) {
+	transport := &http.Transport{}
+	custom := &http.Client{Timeout: 3 * time.Second}
+
+	testCases := []struct {
+		name            string
+		opts            options.Options
+		expectedTimeout time.Duration
+		expectedRT      http.RoundTripper
+	}{
+		{name: "defaults", opts: options.Options{}, expectedTimeout: options.DefaultHTTPTimeout},
+		{name: "custom client timeout", opts: options.Options{HTTPClient: custom}, expectedTimeout: 3 * time.Second},
+		{
+			name:            "explicit timeout and transport win",
+			opts:            options.Options{HTTPClient: custom, HTTPTransport: transport, HTTPTimeout: time.Second},
+			expectedTimeout: time.Second,
+			expectedRT:      transport,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			request := tc.opts.RequestHTTPClient()
+			stream := tc.opts.StreamHTTPClient()
+
+			assert.Equal(t, tc.expectedTimeout, request.Timeout)
+			assert.Equal(t, tc.expectedRT, request.Transport)
+			assert.Equal(t, time.Duration(0), stream.Timeout)
+			assert.Equal(t, tc.expectedRT, stream.Transport)
+		})
+	}
+
+	assert.Equal(t, 3*time.Second, custom.Timeout, "the caller's client is not modified")
+}
+
+func TestSetHTTPHeaders(t *testing.T) {
+	opts := options.Options{HTTPHeaders: map[string]string{"x-proxy-token": "abc"}}
+	header := http.Header{}
+
+	opts.SetHTTPHeaders(header)
+
+	assert.Equal(t, "abc", header.Get("X-Proxy-Token"))
+}
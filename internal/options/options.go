@@ -2,6 +2,7 @@ package options
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
 	"time"
@@ -23,6 +24,7 @@ const (
 	SdkKeyEnvVar       = "REFORGE_BACKEND_SDK_KEY"
 	LegacyApiKeyEnvVar = "PREFAB_API_KEY"
 	APIURLVar          = "REFORGE_API_URL"
+	EnvironmentsVar    = "REFORGE_ENVIRONMENTS"
 )
 
 func GetDefaultAPIURLs() []string {
@@ -58,14 +60,29 @@ type Options struct {
 	OnInitializationFailure      OnInitializationFailure
 	ContextTelemetryMode         ContextTelemetryMode
 	CollectEvaluationSummaries   bool
+	CollectLoggerCounts          bool
 	TelemetrySyncInterval        time.Duration
 	TelemetryHost                string
 	InstanceHash                 string
 	LoggerKey                    string
+	StrictEvaluation             bool
+	PollingInterval              time.Duration
+	HTTPClient                   *http.Client
+	HTTPTransport                http.RoundTripper
+	HTTPTimeout                  time.Duration
+	HTTPHeaders                  map[string]string
+	CacheDir                     string
+	DatafileReloadInterval       time.Duration
 }
 
 const timeoutDefault = 10.0
 
+// DefaultPollingInterval is used by a poll:// source without an interval when WithPollingInterval isn't set
+const DefaultPollingInterval = 30 * time.Second
+
+// DefaultDatafileReloadInterval is how often watched datafile sources are checked for changes unless WithDatafileReloadInterval is set
+const DefaultDatafileReloadInterval = 2 * time.Second
+
 func GetDefaultOptions() Options {
 	var apiURLs []string
 
@@ -86,8 +103,17 @@ func GetDefaultOptions() Options {
 		}
 	}
 
+	var environmentNames []string
+
+	for _, name := range strings.Split(os.Getenv(EnvironmentsVar), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			environmentNames = append(environmentNames, name)
+		}
+	}
+
 	return Options{
 		SdkKey:                       "",
+		EnvironmentNames:             environmentNames,
 		APIURLs:                      apiURLs,
 		InitializationTimeoutSeconds: timeoutDefault,
 		OnInitializationFailure:      ReturnError,
@@ -103,7 +129,7 @@ func GetDefaultOptions() Options {
 }
 
 func (o *Options) TelemetryEnabled() bool {
-	return o.CollectEvaluationSummaries || o.ContextTelemetryMode != ContextTelemetryModes.None
+	return o.CollectEvaluationSummaries || o.CollectLoggerCounts || o.ContextTelemetryMode != ContextTelemetryModes.None
 }
 
 func (o *Options) SdkKeySettingOrEnvVar() (string, error) {
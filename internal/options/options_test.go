@@ -44,6 +44,14 @@ func TestGetDefaultOptions(t *testing.T) {
 	assert.Equal(t, []options.ConfigSource([]options.ConfigSource{{Store: "DataFile", Raw: "testdata/download.json", Path: "testdata/download.json", Default: false}}), o.Sources)
 }
 
+func TestGetDefaultOptionsReadsEnvironmentNames(t *testing.T) {
+	t.Setenv(options.EnvironmentsVar, "")
+	assert.Nil(t, options.GetDefaultOptions().EnvironmentNames)
+
+	t.Setenv(options.EnvironmentsVar, "production, production.eu,,")
+	assert.Equal(t, []string{"production", "production.eu"}, options.GetDefaultOptions().EnvironmentNames)
+}
+
 func TestOptions_TelemetryEnabledOverride(t *testing.T) {
 	defaultOptions := options.GetDefaultOptions()
 	assert.True(t, defaultOptions.TelemetryEnabled())
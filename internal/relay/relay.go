@@ -0,0 +1,376 @@
+// Package relay serves the configs of one upstream API connection to many
+// SDK instances, speaking the same protocol as the Reforge API.
+package relay
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ReforgeHQ/sdk-go/internal/options"
+	"github.com/ReforgeHQ/sdk-go/internal/sse"
+	"github.com/ReforgeHQ/sdk-go/internal/stores"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+// streamBuffer is how many batches a downstream stream can fall behind before
+// it is dropped. A dropped SDK reconnects and resumes from its high watermark.
+const streamBuffer = 64
+
+// heartbeatInterval is how often an SSE comment is written to idle streams so
+// proxies don't time them out.
+const heartbeatInterval = 30 * time.Second
+
+// Relay holds one upstream APIConfigStore and serves /api/v2/configs/{offset}
+// and /api/v2/sse/config to downstream SDKs from it. Deletions are kept as
+// tombstones so SDKs resuming from a high watermark see them.
+type Relay struct {
+	store     *stores.APIConfigStore
+	telemetry *telemetryForwarder
+	configs   map[string]*prefabProto.Config
+	streams   map[int]chan []byte
+	loaded    chan struct{}
+	sdkKey    string
+	loadOnce  sync.Once
+	nextID    int
+	closed    bool
+	mutex     sync.RWMutex
+}
+
+// New connects to the upstream API configured by opts and returns a Relay
+// serving its configs. Telemetry is forwarded to opts.TelemetryHost every
+// opts.TelemetrySyncInterval; an interval of zero disables telemetry.
+func New(opts options.Options) (*Relay, error) {
+	sdkKey, err := opts.SdkKeySettingOrEnvVar()
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Relay{
+		configs: make(map[string]*prefabProto.Config),
+		streams: make(map[int]chan []byte),
+		loaded:  make(chan struct{}),
+		sdkKey:  sdkKey,
+	}
+
+	if opts.TelemetrySyncInterval > 0 {
+		r.telemetry = newTelemetryForwarder(opts, sdkKey)
+	}
+
+	r.store, err = stores.NewAPIConfigStore(opts, r.markLoaded, r.apply, logStreamState, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Loaded is closed once the first configs have been received from upstream.
+func (r *Relay) Loaded() <-chan struct{} {
+	return r.loaded
+}
+
+// Close disconnects from upstream, ends downstream streams and flushes
+// telemetry that hasn't been forwarded yet. ctx bounds the flush; telemetry
+// still unsent when it is done is dropped.
+func (r *Relay) Close(ctx context.Context) error {
+	err := r.store.Close()
+
+	r.mutex.Lock()
+	r.closed = true
+
+	for id, events := range r.streams {
+		close(events)
+		delete(r.streams, id)
+	}
+	r.mutex.Unlock()
+
+	if r.telemetry != nil {
+		err = errors.Join(err, r.telemetry.close(ctx))
+	}
+
+	return err
+}
+
+// Handler serves the relay's endpoints, plus /healthz, which is 200 once the
+// first configs have been received and 503 before.
+func (r *Relay) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/configs/", r.authorized(r.handleConfigs))
+	mux.HandleFunc("/api/v2/sse/config", r.authorized(r.handleStream))
+	mux.HandleFunc("/api/v1/telemetry", r.authorized(r.handleTelemetry))
+	mux.HandleFunc("/healthz", r.handleHealth)
+
+	return mux
+}
+
+func (r *Relay) markLoaded() {
+	r.loadOnce.Do(func() { close(r.loaded) })
+}
+
+// apply records committed upstream changes and sends them to every stream.
+func (r *Relay) apply(changes []stores.ConfigChange) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	batch := make([]*prefabProto.Config, 0, len(changes))
+
+	for _, change := range changes {
+		config := change.New
+		if config == nil {
+			config = &prefabProto.Config{Key: change.Key}
+		}
+
+		r.configs[change.Key] = config
+		batch = append(batch, config)
+	}
+
+	if len(r.streams) == 0 {
+		return
+	}
+
+	payload, err := r.marshal(batch)
+	if err != nil {
+		slog.Error(fmt.Sprintf("relay: unable to marshal configs: %v", err))
+
+		return
+	}
+
+	for id, events := range r.streams {
+		select {
+		case events <- payload:
+		default:
+			slog.Warn("relay: dropping a stream that fell behind")
+			close(events)
+			delete(r.streams, id)
+		}
+	}
+}
+
+// since returns the configs newer than offset. SDKs starting from scratch
+// don't need tombstones. The caller holds the mutex.
+func (r *Relay) since(offset int64) []*prefabProto.Config {
+	configs := make([]*prefabProto.Config, 0, len(r.configs))
+
+	for _, config := range r.configs {
+		if offset == 0 && len(config.GetRows()) == 0 {
+			continue
+		}
+
+		if config.GetId() > offset {
+			configs = append(configs, config)
+		}
+	}
+
+	return configs
+}
+
+func (r *Relay) marshal(configs []*prefabProto.Config) ([]byte, error) {
+	return proto.Marshal(&prefabProto.Configs{
+		Configs:              configs,
+		ConfigServicePointer: &prefabProto.ConfigServicePointer{ProjectEnvId: r.store.GetProjectEnvID()},
+		DefaultContext:       r.store.DefaultContext(),
+	})
+}
+
+// authorized rejects requests that don't carry the relay's own SDK key, so
+// the relay doesn't hand configs to anyone who can reach it.
+func (r *Relay) authorized(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if !r.validSdkKey(req) {
+			http.Error(w, "invalid SDK key", http.StatusUnauthorized)
+
+			return
+		}
+
+		next(w, req)
+	}
+}
+
+func (r *Relay) validSdkKey(req *http.Request) bool {
+	// SDKs send the key as the basic auth password
+	_, sdkKey, ok := req.BasicAuth()
+	if !ok {
+		encoded, found := strings.CutPrefix(req.Header.Get("Authorization"), "Basic ")
+		if !found {
+			return false
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return false
+		}
+
+		_, sdkKey, _ = strings.Cut(string(decoded), ":")
+	}
+
+	return subtle.ConstantTimeCompare([]byte(sdkKey), []byte(r.sdkKey)) == 1
+}
+
+func (r *Relay) handleHealth(w http.ResponseWriter, _ *http.Request) {
+	select {
+	case <-r.loaded:
+		_, _ = w.Write([]byte("OK"))
+	default:
+		http.Error(w, "waiting for upstream configs", http.StatusServiceUnavailable)
+	}
+}
+
+func (r *Relay) handleConfigs(w http.ResponseWriter, req *http.Request) {
+	offset, err := strconv.ParseInt(strings.TrimPrefix(req.URL.Path, "/api/v2/configs/"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid offset", http.StatusBadRequest)
+
+		return
+	}
+
+	if !r.waitForLoad(req) {
+		http.Error(w, "waiting for upstream configs", http.StatusServiceUnavailable)
+
+		return
+	}
+
+	r.mutex.RLock()
+	payload, err := r.marshal(r.since(offset))
+	r.mutex.RUnlock()
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	_, _ = w.Write(payload)
+}
+
+func (r *Relay) handleStream(w http.ResponseWriter, req *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+
+		return
+	}
+
+	if !r.waitForLoad(req) {
+		http.Error(w, "waiting for upstream configs", http.StatusServiceUnavailable)
+
+		return
+	}
+
+	startAt, _ := strconv.ParseInt(req.Header.Get("x-prefab-start-at-id"), 10, 64)
+	events := make(chan []byte, streamBuffer)
+
+	// Register and snapshot under one lock so no change falls between them
+	r.mutex.Lock()
+	if r.closed {
+		r.mutex.Unlock()
+		http.Error(w, "relay is shutting down", http.StatusServiceUnavailable)
+
+		return
+	}
+
+	id := r.nextID
+	r.nextID++
+	r.streams[id] = events
+
+	var initial []byte
+	if configs := r.since(startAt); len(configs) > 0 {
+		initial, _ = r.marshal(configs)
+	}
+	r.mutex.Unlock()
+
+	defer func() {
+		r.mutex.Lock()
+		if r.streams[id] == events {
+			delete(r.streams, id)
+		}
+		r.mutex.Unlock()
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	if initial != nil {
+		writeEvent(w, initial)
+	}
+
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-req.Context().Done():
+			return
+		case payload, open := <-events:
+			if !open {
+				return
+			}
+
+			writeEvent(w, payload)
+			flusher.Flush()
+		case <-heartbeat.C:
+			_, _ = w.Write([]byte(": heartbeat\n\n"))
+			flusher.Flush()
+		}
+	}
+}
+
+func (r *Relay) handleTelemetry(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	if r.telemetry == nil {
+		// Accept and discard, so SDKs don't retry
+		w.WriteHeader(http.StatusOK)
+
+		return
+	}
+
+	if err := r.telemetry.accept(req.Body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (r *Relay) waitForLoad(req *http.Request) bool {
+	select {
+	case <-r.loaded:
+		return true
+	case <-req.Context().Done():
+		return false
+	}
+}
+
+func writeEvent(w http.ResponseWriter, payload []byte) {
+	_, _ = fmt.Fprintf(w, "data: %s\n\n", base64.StdEncoding.EncodeToString(payload))
+}
+
+func logStreamState(state sse.ConnectionState, url string, err error) {
+	if err != nil {
+		slog.Warn(fmt.Sprintf("relay: upstream stream %s: %s: %v", url, state, err))
+
+		return
+	}
+
+	slog.Info(fmt.Sprintf("relay: upstream stream %s: %s", url, state))
+}
@@ -0,0 +1,205 @@
+package relay_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	reforge "github.com/ReforgeHQ/sdk-go"
+	"github.com/ReforgeHQ/sdk-go/internal/options"
+	"github.com/ReforgeHQ/sdk-go/internal/relay"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+	"github.com/ReforgeHQ/sdk-go/reforgetest"
+)
+
+func startRelay(t *testing.T, upstream *reforgetest.Server) *httptest.Server {
+	t.Helper()
+
+	opts := options.GetDefaultOptions()
+	opts.SdkKey = reforgetest.ServerSdkKey
+	opts.APIURLs = []string{upstream.URL()}
+	opts.TelemetryHost = upstream.URL()
+	opts.TelemetrySyncInterval = time.Hour
+
+	r, err := relay.New(opts)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(r.Handler())
+
+	t.Cleanup(func() {
+		_ = r.Close(context.Background())
+		server.Close()
+	})
+
+	select {
+	case <-r.Loaded():
+	case <-time.After(5 * time.Second):
+		t.Fatal("relay never loaded upstream configs")
+	}
+
+	return server
+}
+
+func startDownstream(t *testing.T, relayURL string, opts ...reforge.Option) *reforge.Client {
+	t.Helper()
+
+	opts = append([]reforge.Option{
+		reforge.WithSdkKey(reforgetest.ServerSdkKey),
+		reforge.WithAPIURLs([]string{relayURL}),
+		reforge.WithTelemetryHost(relayURL),
+		reforge.WithInitializationTimeoutSeconds(5),
+	}, opts...)
+
+	client, err := reforge.NewSdk(opts...)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = client.Close(context.Background())
+	})
+
+	return client
+}
+
+func eventuallyString(t *testing.T, client *reforge.Client, key string, expected string) {
+	t.Helper()
+
+	assert.Eventually(t, func() bool {
+		value, ok, err := client.GetStringValue(key, *reforge.NewContextSet())
+
+		return err == nil && ok && value == expected
+	}, 5*time.Second, 10*time.Millisecond, "%s never became %q", key, expected)
+}
+
+func TestRelayFansOutUpstreamUpdates(t *testing.T) {
+	upstream := reforgetest.NewServer(t, reforgetest.Config("greeting").Otherwise("hello").Build())
+	relayServer := startRelay(t, upstream)
+
+	first := startDownstream(t, relayServer.URL, reforge.WithAllTelemetryDisabled())
+	second := startDownstream(t, relayServer.URL, reforge.WithAllTelemetryDisabled())
+
+	eventuallyString(t, first, "greeting", "hello")
+	eventuallyString(t, second, "greeting", "hello")
+
+	upstream.Push(reforgetest.Config("greeting").Otherwise("bonjour").Build())
+
+	eventuallyString(t, first, "greeting", "bonjour")
+	eventuallyString(t, second, "greeting", "bonjour")
+
+	upstream.Delete("greeting")
+
+	assert.Eventually(t, func() bool {
+		_, ok, _ := second.GetStringValue("greeting", *reforge.NewContextSet())
+
+		return !ok
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, 1, upstream.StreamsOpened(), "downstream SDKs must share the relay's upstream stream")
+}
+
+func TestRelayServesConfigsSinceOffset(t *testing.T) {
+	upstream := reforgetest.NewServer(t,
+		reforgetest.Config("a").Otherwise("1").Build(),
+		reforgetest.Config("b").Otherwise("2").Build(),
+	)
+	relayServer := startRelay(t, upstream)
+
+	upstream.Delete("a")
+	upstream.Push(reforgetest.Config("c").Otherwise("3").Build())
+
+	fetch := func(offset string, sdkKey string) (int, map[string]int) {
+		request, err := http.NewRequest(http.MethodGet, relayServer.URL+"/api/v2/configs/"+offset, nil)
+		require.NoError(t, err)
+		request.SetBasicAuth("1", sdkKey)
+
+		response, err := http.DefaultClient.Do(request)
+		require.NoError(t, err)
+
+		defer response.Body.Close()
+
+		body, err := io.ReadAll(response.Body)
+		require.NoError(t, err)
+
+		rows := map[string]int{}
+		if response.StatusCode != http.StatusOK {
+			return response.StatusCode, rows
+		}
+
+		var configs prefabProto.Configs
+		require.NoError(t, proto.Unmarshal(body, &configs))
+
+		for _, config := range configs.GetConfigs() {
+			rows[config.GetKey()] = len(config.GetRows())
+		}
+
+		return response.StatusCode, rows
+	}
+
+	require.Eventually(t, func() bool {
+		_, rows := fetch("0", reforgetest.ServerSdkKey)
+
+		return rows["c"] == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	status, rows := fetch("0", reforgetest.ServerSdkKey)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, map[string]int{"b": 1, "c": 1}, rows, "a fresh SDK gets no tombstones")
+
+	_, rows = fetch("2", reforgetest.ServerSdkKey)
+	assert.Equal(t, map[string]int{"a": 0, "c": 1}, rows, "a resuming SDK gets the deletion")
+
+	status, _ = fetch("0", "wrong-key")
+	assert.Equal(t, http.StatusUnauthorized, status)
+}
+
+func TestRelayForwardsTelemetryOnClose(t *testing.T) {
+	upstream := reforgetest.NewServer(t, reforgetest.Config("greeting").Otherwise("hello").Build())
+
+	opts := options.GetDefaultOptions()
+	opts.SdkKey = reforgetest.ServerSdkKey
+	opts.APIURLs = []string{upstream.URL()}
+	opts.TelemetryHost = upstream.URL()
+	opts.TelemetrySyncInterval = time.Hour
+
+	r, err := relay.New(opts)
+	require.NoError(t, err)
+
+	relayServer := httptest.NewServer(r.Handler())
+	t.Cleanup(relayServer.Close)
+
+	client := startDownstream(t, relayServer.URL, reforge.WithCollectEvaluationSummaries(true))
+	eventuallyString(t, client, "greeting", "hello")
+	require.NoError(t, client.SendTelemetry(true))
+
+	assert.Empty(t, upstream.TelemetryEvents(), "telemetry is batched until the interval or close")
+
+	require.NoError(t, r.Close(context.Background()))
+
+	var keys []string
+
+	for _, events := range upstream.TelemetryEvents() {
+		for _, event := range events.GetEvents() {
+			for _, summary := range event.GetSummaries().GetSummaries() {
+				keys = append(keys, summary.GetKey())
+			}
+		}
+	}
+
+	assert.Contains(t, keys, "greeting")
+}
+
+func TestRelayHealth(t *testing.T) {
+	upstream := reforgetest.NewServer(t, reforgetest.Config("greeting").Otherwise("hello").Build())
+	relayServer := startRelay(t, upstream)
+
+	response, err := http.Get(relayServer.URL + "/healthz")
+	require.NoError(t, err)
+	response.Body.Close()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+}
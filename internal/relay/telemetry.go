@@ -0,0 +1,166 @@
+package relay
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ReforgeHQ/sdk-go/internal"
+	"github.com/ReforgeHQ/sdk-go/internal/options"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+// maxPendingEvents bounds the telemetry held between flushes; beyond it, new
+// events are dropped rather than growing without limit during an outage.
+const maxPendingEvents = 100000
+
+// telemetryForwarder batches the telemetry posted by downstream SDKs and
+// forwards it upstream on an interval, one request per SDK instance.
+type telemetryForwarder struct {
+	options    options.Options
+	httpClient *http.Client
+	pending    map[string][]*prefabProto.TelemetryEvent
+	done       chan struct{}
+	stopped    chan struct{}
+	url        string
+	authHeader string
+	count      int
+	closeOnce  sync.Once
+	mutex      sync.Mutex
+}
+
+func newTelemetryForwarder(opts options.Options, sdkKey string) *telemetryForwarder {
+	f := &telemetryForwarder{
+		options:    opts,
+		httpClient: opts.RequestHTTPClient(),
+		pending:    make(map[string][]*prefabProto.TelemetryEvent),
+		done:       make(chan struct{}),
+		stopped:    make(chan struct{}),
+		url:        fmt.Sprintf("%s/api/v1/telemetry", opts.TelemetryHost),
+		authHeader: "Basic " + base64.StdEncoding.EncodeToString([]byte("authuser:"+sdkKey)),
+	}
+
+	go f.run(opts.TelemetrySyncInterval)
+
+	return f
+}
+
+// accept decodes a TelemetryEvents body and queues its events.
+func (f *telemetryForwarder) accept(body io.Reader) error {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+
+	var events prefabProto.TelemetryEvents
+	if err := proto.Unmarshal(data, &events); err != nil {
+		return fmt.Errorf("invalid telemetry: %w", err)
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if f.count+len(events.GetEvents()) > maxPendingEvents {
+		slog.Warn(fmt.Sprintf("relay: dropping %d telemetry events, %d already pending", len(events.GetEvents()), f.count))
+
+		return nil
+	}
+
+	f.pending[events.GetInstanceHash()] = append(f.pending[events.GetInstanceHash()], events.GetEvents()...)
+	f.count += len(events.GetEvents())
+
+	return nil
+}
+
+func (f *telemetryForwarder) run(interval time.Duration) {
+	defer close(f.stopped)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-f.done:
+			return
+		case <-ticker.C:
+			if err := f.flush(context.Background()); err != nil {
+				slog.Warn(fmt.Sprintf("relay: forwarding telemetry: %v", err))
+			}
+		}
+	}
+}
+
+// flush forwards everything pending. Batches that fail are dropped, like the
+// SDK's own telemetry after its retries.
+func (f *telemetryForwarder) flush(ctx context.Context) error {
+	f.mutex.Lock()
+	pending := f.pending
+	f.pending = make(map[string][]*prefabProto.TelemetryEvent)
+	f.count = 0
+	f.mutex.Unlock()
+
+	var errs []error
+
+	for instanceHash, events := range pending {
+		if err := f.post(ctx, &prefabProto.TelemetryEvents{InstanceHash: instanceHash, Events: events}); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (f *telemetryForwarder) post(ctx context.Context, events *prefabProto.TelemetryEvents) error {
+	payload, err := proto.Marshal(events)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	f.options.SetHTTPHeaders(req.Header)
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("Accept", "application/x-protobuf")
+	req.Header.Set("X-Reforge-SDK-Version", internal.ClientVersionHeader)
+	req.Header.Set("Authorization", f.authHeader)
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("telemetry for instance %s rejected: %s", events.GetInstanceHash(), resp.Status)
+	}
+
+	return nil
+}
+
+// close stops the interval and forwards what is still pending, giving up
+// when ctx is done.
+func (f *telemetryForwarder) close(ctx context.Context) error {
+	var err error
+
+	f.closeOnce.Do(func() {
+		close(f.done)
+		<-f.stopped
+
+		err = f.flush(ctx)
+	})
+
+	return err
+}
@@ -1,15 +1,20 @@
 package sse
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
+	"fmt"
 	"log/slog"
+	"net/http"
 	"regexp"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	sse "github.com/r3labs/sse/v2"
 	"google.golang.org/protobuf/proto"
+	"gopkg.in/cenkalti/backoff.v1"
 
 	"github.com/ReforgeHQ/sdk-go/internal"
 	"github.com/ReforgeHQ/sdk-go/internal/options"
@@ -18,7 +23,52 @@ import (
 
 var subdomainRegex = regexp.MustCompile(`(primary|secondary)\.`)
 
-func BuildSSEClient(options options.Options) (*sse.Client, *options.Options, error) {
+// primaryRecheckInterval is how long a stream to a fallback URL is kept open
+// before trying the primary URL again.
+const primaryRecheckInterval = 5 * time.Minute
+
+// stableStreamDuration is how long a stream has to stay up, if it delivers
+// no events, before the reconnect backoff starts over.
+const stableStreamDuration = time.Minute
+
+var errRecheckPrimary = errors.New("recheck primary stream")
+
+// ConnectionState is the state of the SSE stream.
+type ConnectionState int
+
+const (
+	// Connecting means a connection attempt to URL is in progress
+	Connecting ConnectionState = iota + 1
+	// Connected means the stream to URL is open
+	Connected
+	// Disconnected means the stream to URL failed or was closed; Err holds the reason, if any
+	Disconnected
+)
+
+// String returns the string representation of the ConnectionState
+func (s ConnectionState) String() string {
+	switch s {
+	case Connecting:
+		return "CONNECTING"
+	case Connected:
+		return "CONNECTED"
+	case Disconnected:
+		return "DISCONNECTED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// ConnectionStateHandler is called on the streaming goroutine whenever the connection state changes.
+type ConnectionStateHandler func(state ConnectionState, url string, err error)
+
+// BuildSSEClients returns a client for the stream of each configured API URL,
+// in order, with duplicates removed. The first is the primary.
+//
+// The default primary and secondary API URLs share stream.reforge.com, so the
+// defaults build a single client; failover to another stream host needs
+// explicit API URLs.
+func BuildSSEClients(options options.Options) ([]*sse.Client, *options.Options, error) {
 	apiURLs, err := options.PrefabAPIURLEnvVarOrSetting()
 	if err != nil {
 		return nil, nil, err
@@ -28,12 +78,25 @@ func BuildSSEClient(options options.Options) (*sse.Client, *options.Options, err
 		return nil, nil, errors.New("no api urls provided")
 	}
 
-	// TODO: handle multiple api urls
-	url := replaceFirstOccurrence(apiURLs[0], subdomainRegex, "stream.") + "/api/v2/sse/config"
-	client := sse.NewClient(url)
+	clients := make([]*sse.Client, 0, len(apiURLs))
+	seen := make(map[string]bool, len(apiURLs))
+
+	for _, apiURL := range apiURLs {
+		url := replaceFirstOccurrence(apiURL, subdomainRegex, "stream.") + "/api/v2/sse/config"
+		if seen[url] {
+			continue
+		}
+
+		seen[url] = true
+
+		client := sse.NewClient(url)
+		client.Connection = options.StreamHTTPClient()
+
+		clients = append(clients, client)
+	}
 
-	// Return client and options - headers will be set when connecting
-	return client, &options, nil
+	// Return clients and options - headers will be set when connecting
+	return clients, &options, nil
 }
 
 type ConfigStore interface {
@@ -41,7 +104,12 @@ type ConfigStore interface {
 	GetHighWatermark() int64
 }
 
-func StartSSEConnection(client *sse.Client, opts *options.Options, apiConfigStore ConfigStore) {
+// StartSSEConnection streams config updates into apiConfigStore until ctx is
+// done. When a stream fails it moves on to the next client, waiting an
+// exponentially growing, jittered delay between attempts. A stream to a
+// fallback client is recycled after primaryRecheckInterval so the primary is
+// used again once it recovers. onState may be nil.
+func StartSSEConnection(ctx context.Context, clients []*sse.Client, opts *options.Options, apiConfigStore ConfigStore, onState ConnectionStateHandler) {
 	// Get SDK key when actually connecting
 	sdkKey, err := opts.SdkKeySettingOrEnvVar()
 	if err != nil {
@@ -49,55 +117,144 @@ func StartSSEConnection(client *sse.Client, opts *options.Options, apiConfigStor
 		return
 	}
 
+	if onState == nil {
+		onState = func(ConnectionState, string, error) {}
+	}
+
 	authString := base64.StdEncoding.EncodeToString([]byte("authuser:" + sdkKey))
 
-	// Set headers once
-	client.Headers = map[string]string{
-		"Authorization":         "Basic " + authString,
-		"X-Reforge-SDK-Version": internal.ClientVersionHeader,
-		"Accept":                "text/event-stream",
+	reconnectBackoff := backoff.NewExponentialBackOff()
+	reconnectBackoff.MaxElapsedTime = 0 // never give up
+
+	for _, client := range clients {
+		// Set headers once; custom headers can't override the SDK's own
+		client.Headers = make(map[string]string, len(opts.HTTPHeaders)+4)
+
+		for name, value := range opts.HTTPHeaders {
+			client.Headers[http.CanonicalHeaderKey(name)] = value
+		}
+
+		client.Headers["Authorization"] = "Basic " + authString
+		client.Headers["X-Reforge-SDK-Version"] = internal.ClientVersionHeader
+		client.Headers["Accept"] = "text/event-stream"
+
+		// Each subscription is a single attempt; retries and failover happen below
+		client.ReconnectStrategy = &backoff.StopBackOff{}
+
+		client.ResponseValidator = func(client *sse.Client, resp *http.Response) error {
+			if resp.StatusCode != http.StatusOK {
+				resp.Body.Close()
+
+				return fmt.Errorf("could not connect to stream: %s", resp.Status)
+			}
+
+			onState(Connected, client.URL, nil)
+
+			return nil
+		}
 	}
 
+	index := 0
+
 	for {
+		client := clients[index]
 		client.Headers["x-prefab-start-at-id"] = strconv.FormatInt(apiConfigStore.GetHighWatermark(), 10)
 
-		err := client.Subscribe("", func(msg *sse.Event) {
-			// Skip empty events (phantom events from SSE library bug when processing comments)
-			if len(msg.Data) == 0 {
-				return
-			}
+		onState(Connecting, client.URL, nil)
 
-			decoded := make([]byte, base64.StdEncoding.DecodedLen(len(msg.Data)))
+		started := time.Now()
 
-			numberOfBytesWritten, err := base64.StdEncoding.Decode(decoded, msg.Data)
-			if err != nil {
-				slog.Error("sse: error decoding base64 data", "err", err.Error())
+		delivered, err := subscribe(ctx, client, index > 0, apiConfigStore)
+		if ctx.Err() != nil {
+			onState(Disconnected, client.URL, nil)
 
-				return
-			}
+			return
+		}
 
-			// Trim the decoded slice to the actual length of the decoded data
-			decoded = decoded[:numberOfBytesWritten]
+		// A stream that drops right after connecting keeps backing off
+		if delivered || time.Since(started) >= stableStreamDuration {
+			reconnectBackoff.Reset()
+		}
 
-			var configs prefabProto.Configs
+		if errors.Is(err, errRecheckPrimary) {
+			slog.Debug("sse: reconnecting to the primary stream", "from", client.URL)
+			onState(Disconnected, client.URL, nil)
 
-			err = proto.Unmarshal(decoded, &configs)
-			if err != nil {
-				slog.Error("sse: error unmarshalling proto", "err", err.Error())
+			index = 0
 
-				return
-			}
+			continue
+		}
+
+		onState(Disconnected, client.URL, err)
 
-			apiConfigStore.SetFromConfigsProto(&configs)
-		})
 		if err != nil {
 			slog.Error("sse:", "err", err.Error())
+
+			index = (index + 1) % len(clients)
+		} else {
+			// The server closed the stream cleanly; start over from the primary
+			index = 0
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectBackoff.NextBackOff()):
 		}
+	}
+}
+
+// subscribe runs a single stream until it ends and reports whether it
+// delivered any configs. Streams to a fallback are ended with
+// errRecheckPrimary after primaryRecheckInterval.
+func subscribe(ctx context.Context, client *sse.Client, isFallback bool, apiConfigStore ConfigStore) (bool, error) {
+	var delivered atomic.Bool
 
-		// If we get here, the connection was closed. We should try to reconnect.
-		// We sleep for a second to avoid hammering the server.
-		time.Sleep(1 * time.Second)
+	streamCtx := ctx
+
+	if isFallback {
+		var cancel context.CancelFunc
+
+		streamCtx, cancel = context.WithTimeout(ctx, primaryRecheckInterval)
+		defer cancel()
 	}
+
+	err := client.SubscribeWithContext(streamCtx, "", func(msg *sse.Event) {
+		// Skip empty events (phantom events from SSE library bug when processing comments)
+		if len(msg.Data) == 0 {
+			return
+		}
+
+		decoded := make([]byte, base64.StdEncoding.DecodedLen(len(msg.Data)))
+
+		numberOfBytesWritten, err := base64.StdEncoding.Decode(decoded, msg.Data)
+		if err != nil {
+			slog.Error("sse: error decoding base64 data", "err", err.Error())
+
+			return
+		}
+
+		// Trim the decoded slice to the actual length of the decoded data
+		decoded = decoded[:numberOfBytesWritten]
+
+		var configs prefabProto.Configs
+
+		err = proto.Unmarshal(decoded, &configs)
+		if err != nil {
+			slog.Error("sse: error unmarshalling proto", "err", err.Error())
+
+			return
+		}
+
+		delivered.Store(true)
+		apiConfigStore.SetFromConfigsProto(&configs)
+	})
+
+	if ctx.Err() == nil && streamCtx.Err() != nil {
+		return delivered.Load(), errRecheckPrimary
+	}
+
+	return delivered.Load(), err
 }
 
 func replaceFirstOccurrence(s string, r *regexp.Regexp, replacement string) string {
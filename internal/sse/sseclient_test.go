@@ -1,12 +1,20 @@
 package sse_test
 
 import (
+	"context"
 	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"sync"
 	"testing"
+	"time"
 
 	r3sse "github.com/r3labs/sse/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/ReforgeHQ/sdk-go/internal/options"
 	sse "github.com/ReforgeHQ/sdk-go/internal/sse"
@@ -19,16 +27,131 @@ func TestBuildSSEClient(t *testing.T) {
 		APIURLs: []string{"https://primary.reforge.com"},
 	}
 
-	client, opts, err := sse.BuildSSEClient(options)
+	clients, opts, err := sse.BuildSSEClients(options)
 
 	assert.NoError(t, err)
-	assert.Equal(t, "https://stream.reforge.com/api/v2/sse/config", client.URL)
+	assert.Len(t, clients, 1)
+	assert.Equal(t, "https://stream.reforge.com/api/v2/sse/config", clients[0].URL)
 	assert.NotNil(t, opts)
 
 	// Headers are not set until StartSSEConnection is called
 	// This test verifies the client is created with the correct URL
 }
 
+func TestBuildSSEClientsDeduplicatesStreamURLs(t *testing.T) {
+	options := options.Options{
+		SdkKey:  "does-not-matter",
+		APIURLs: []string{"https://primary.reforge.com", "https://secondary.reforge.com", "https://backup.example.com"},
+	}
+
+	clients, _, err := sse.BuildSSEClients(options)
+
+	require.NoError(t, err)
+	require.Len(t, clients, 2)
+	assert.Equal(t, "https://stream.reforge.com/api/v2/sse/config", clients[0].URL)
+	assert.Equal(t, "https://backup.example.com/api/v2/sse/config", clients[1].URL)
+}
+
+func TestBuildSSEClientsWithDefaultAPIURLs(t *testing.T) {
+	options := options.Options{
+		SdkKey:  "does-not-matter",
+		APIURLs: options.GetDefaultAPIURLs(),
+	}
+
+	clients, _, err := sse.BuildSSEClients(options)
+
+	// primary. and secondary. both map to stream., so there is no stream to fail over to
+	require.NoError(t, err)
+	require.Len(t, clients, 1)
+	assert.Equal(t, "https://stream.reforge.com/api/v2/sse/config", clients[0].URL)
+}
+
+type syncConfigStore struct {
+	received chan *prefabProto.Configs
+}
+
+func (s *syncConfigStore) SetFromConfigsProto(configs *prefabProto.Configs) {
+	s.received <- configs
+}
+
+func (s *syncConfigStore) GetHighWatermark() int64 {
+	return 0
+}
+
+func TestStartSSEConnectionFailsOverToNextURL(t *testing.T) {
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer primary.Close()
+
+	payload, err := proto.Marshal(&prefabProto.Configs{Configs: []*prefabProto.Config{{Key: "from-secondary", Id: 1}}})
+	require.NoError(t, err)
+
+	secondary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprintf(w, "data: %s\n\n", base64.StdEncoding.EncodeToString(payload))
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer secondary.Close()
+
+	clients, opts, err := sse.BuildSSEClients(options.Options{SdkKey: "test-key", APIURLs: []string{primary.URL, secondary.URL}})
+	require.NoError(t, err)
+	require.Len(t, clients, 2)
+
+	type stateChange struct {
+		url   string
+		state sse.ConnectionState
+		err   bool
+	}
+
+	var (
+		mutex  sync.Mutex
+		states []stateChange
+	)
+
+	onState := func(state sse.ConnectionState, url string, err error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		states = append(states, stateChange{url: url, state: state, err: err != nil})
+	}
+
+	store := &syncConfigStore{received: make(chan *prefabProto.Configs, 1)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		sse.StartSSEConnection(ctx, clients, opts, store, onState)
+	}()
+
+	select {
+	case configs := <-store.received:
+		assert.Equal(t, "from-secondary", configs.GetConfigs()[0].GetKey())
+	case <-time.After(5 * time.Second):
+		t.Fatal("no configs received from the secondary stream")
+	}
+
+	cancel()
+	<-done
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	primaryURL := primary.URL + "/api/v2/sse/config"
+	secondaryURL := secondary.URL + "/api/v2/sse/config"
+
+	require.GreaterOrEqual(t, len(states), 4)
+	assert.Equal(t, []stateChange{
+		{url: primaryURL, state: sse.Connecting},
+		{url: primaryURL, state: sse.Disconnected, err: true},
+		{url: secondaryURL, state: sse.Connecting},
+		{url: secondaryURL, state: sse.Connected},
+	}, states[:4])
+}
+
 type mockConfigStore struct {
 	highWatermark int64
 	lastConfigs   *prefabProto.Configs
@@ -71,10 +194,10 @@ func TestBuildSSEClientWithEnvVar(t *testing.T) {
 		APIURLs: []string{"https://primary.reforge.com"},
 	}
 
-	client, sseOpts, err := sse.BuildSSEClient(opts)
+	clients, sseOpts, err := sse.BuildSSEClients(opts)
 
 	assert.NoError(t, err)
-	assert.Equal(t, "https://stream.reforge.com/api/v2/sse/config", client.URL)
+	assert.Equal(t, "https://stream.reforge.com/api/v2/sse/config", clients[0].URL)
 	assert.NotNil(t, sseOpts)
 
 	// Simulate what StartSSEConnection does - get SDK key and set headers
@@ -102,10 +225,10 @@ func TestBuildSSEClientExplicitKeyTakesPrecedence(t *testing.T) {
 		APIURLs: []string{"https://primary.reforge.com"},
 	}
 
-	client, sseOpts, err := sse.BuildSSEClient(opts)
+	clients, sseOpts, err := sse.BuildSSEClients(opts)
 
 	assert.NoError(t, err)
-	assert.NotNil(t, client)
+	assert.NotEmpty(t, clients)
 	assert.NotNil(t, sseOpts)
 
 	// Verify that SdkKeySettingOrEnvVar returns the explicit key
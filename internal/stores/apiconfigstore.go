@@ -1,8 +1,12 @@
 package stores
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"log/slog"
+	"maps"
 	"sync"
 	"time"
 
@@ -18,24 +22,37 @@ const maxRetries = 10
 type APIConfigStore struct {
 	configMap       map[string]*prefabProto.Config
 	contextSet      *contexts.ContextSet
+	defaultContext  *prefabProto.ContextSet
+	cache           *configCache
 	httpClient      *internal.HTTPClient
 	finishedLoading func()
+	loadedOnce      sync.Once
+	onChange        ConfigChangeHandler
+	onViolation     SchemaViolationHandler
+	ctx             context.Context
+	cancel          context.CancelFunc
 	highWatermark   int64
 	projectEnvID    int64
 	sync.RWMutex
 	Initialized bool
 }
 
-func NewAPIConfigStore(options options.Options, finishedLoading func()) (*APIConfigStore, error) {
+// NewAPIConfigStore fetches configs from the API and keeps them up to date.
+// JSON values of configs with a SchemaKey are validated against that schema
+// config when either changes; a config that fails is rejected, the key keeps
+// its previous version if that still matches, and the failure is passed to
+// onViolation. Updates are fetched from the highest config id seen, which
+// includes rejected ones, so a rejected config is not fetched again: the key
+// stays as it is until the config is next edited, or until a restart without
+// a cache dir fetches everything again. onChange, onStreamState and
+// onViolation may be nil.
+func NewAPIConfigStore(options options.Options, finishedLoading func(), onChange ConfigChangeHandler, onStreamState sse.ConnectionStateHandler, onViolation SchemaViolationHandler) (*APIConfigStore, error) {
 	httpClient, err := internal.BuildHTTPClient(options)
 	if err != nil {
 		panic(err)
 	}
 
-	sseClient, sseOpts, err := sse.BuildSSEClient(options)
-	if err != nil {
-		panic(err)
-	}
+	ctx, cancel := context.WithCancel(context.Background())
 
 	store := &APIConfigStore{
 		configMap:       make(map[string]*prefabProto.Config),
@@ -44,13 +61,37 @@ func NewAPIConfigStore(options options.Options, finishedLoading func()) (*APICon
 		projectEnvID:    0,
 		httpClient:      httpClient,
 		finishedLoading: finishedLoading,
+		onChange:        onChange,
+		onViolation:     onViolation,
+		ctx:             ctx,
+		cancel:          cancel,
 	}
 
-	go func() {
-		err = store.fetchFromServer(0, func() {
-			go sse.StartSSEConnection(sseClient, sseOpts, store)
-		})
+	if options.CacheDir != "" {
+		if sdkKey, err := options.SdkKeySettingOrEnvVar(); err == nil {
+			store.cache = newConfigCache(options.CacheDir, sdkKey)
+			store.loadCache()
+		}
+	}
+
+	startUpdates := func() {
+		go store.poll(options.PollingInterval)
+	}
+
+	if options.PollingInterval <= 0 {
+		sseClients, sseOpts, err := sse.BuildSSEClients(options)
 		if err != nil {
+			panic(err)
+		}
+
+		startUpdates = func() {
+			go sse.StartSSEConnection(ctx, sseClients, sseOpts, store, onStreamState)
+		}
+	}
+
+	go func() {
+		err := store.fetchFromServer(0, startUpdates)
+		if err != nil && ctx.Err() == nil {
 			slog.Error(fmt.Sprintf("error fetching from server: %v", err))
 		}
 	}()
@@ -59,23 +100,141 @@ func NewAPIConfigStore(options options.Options, finishedLoading func()) (*APICon
 }
 
 func (cs *APIConfigStore) SetConfigs(configs []*prefabProto.Config, envID int64) {
+	changes, violations := cs.commitConfigs(configs, envID)
+
+	logSchemaViolations(violations)
+
+	if cs.onViolation != nil && len(violations) > 0 {
+		cs.onViolation(violations)
+	}
+
+	if cs.onChange != nil && len(changes) > 0 {
+		cs.onChange(changes)
+	}
+}
+
+func (cs *APIConfigStore) commitConfigs(configs []*prefabProto.Config, envID int64) ([]ConfigChange, []SchemaViolation) {
 	cs.Lock()
 	defer cs.Unlock()
 	cs.Initialized = true
 	cs.projectEnvID = envID
 
+	previous := maps.Clone(cs.configMap)
+
+	var changes []ConfigChange
+
 	for _, config := range configs {
-		cs.setConfig(config)
+		if change, changed := cs.setConfig(config); changed {
+			changes = append(changes, change)
+		}
+	}
+
+	// Validate once the whole batch is in, so a schema and the values using it can change together
+	violations := rejectSchemaViolations(cs.configMap, previous)
+	if len(violations) == 0 {
+		return changes, nil
+	}
+
+	rejected := make(map[string]bool, len(violations))
+	for _, violation := range violations {
+		rejected[violation.Key] = true
+	}
+
+	accepted := changes[:0]
+
+	for _, change := range changes {
+		if !rejected[change.Key] {
+			accepted = append(accepted, change)
+		}
+	}
+
+	// A key that had a version before the batch but none now was removed by validation
+	for key := range rejected {
+		if _, exists := cs.configMap[key]; !exists && previous[key] != nil {
+			accepted = append(accepted, ConfigChange{Key: key, Old: previous[key], New: &prefabProto.Config{Key: key}})
+		}
 	}
+
+	return accepted, violations
 }
 
+// SetFromConfigsProto applies a batch of configs from the API and, when a
+// cache dir is configured, saves the resulting state to disk.
 func (cs *APIConfigStore) SetFromConfigsProto(configs *prefabProto.Configs) {
-	cs.contextSet = contexts.NewContextSetFromProto(configs.GetDefaultContext())
+	cs.applyConfigsProto(configs)
+
+	if cs.cache != nil {
+		snapshot, highWatermark := cs.snapshot()
+
+		if err := cs.cache.save(snapshot, highWatermark); err != nil {
+			slog.Warn(fmt.Sprintf("unable to write config cache: %v", err))
+		}
+	}
+}
+
+func (cs *APIConfigStore) applyConfigsProto(configs *prefabProto.Configs) {
+	contextSet := contexts.NewContextSetFromProto(configs.GetDefaultContext())
+
+	cs.Lock()
+	cs.contextSet = contextSet
+	cs.defaultContext = configs.GetDefaultContext()
+	cs.Unlock()
+
 	cs.SetConfigs(configs.GetConfigs(), configs.GetConfigServicePointer().GetProjectEnvId())
 }
 
+// snapshot returns the store's full state, as opposed to the last batch applied.
+func (cs *APIConfigStore) snapshot() (*prefabProto.Configs, int64) {
+	cs.RLock()
+	defer cs.RUnlock()
+
+	configs := &prefabProto.Configs{
+		Configs:              make([]*prefabProto.Config, 0, len(cs.configMap)),
+		ConfigServicePointer: &prefabProto.ConfigServicePointer{ProjectEnvId: cs.projectEnvID},
+		DefaultContext:       cs.defaultContext,
+	}
+
+	for _, config := range cs.configMap {
+		configs.Configs = append(configs.Configs, config)
+	}
+
+	return configs, cs.highWatermark
+}
+
+// loadCache serves the cached snapshot, if there is a valid one, until the API
+// responds. The first fetch then resumes from the cached high watermark.
+func (cs *APIConfigStore) loadCache() {
+	configs, highWatermark, err := cs.cache.load()
+
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		slog.Debug("no config cache found", "path", cs.cache.path)
+
+		return
+	case err != nil:
+		slog.Warn(fmt.Sprintf("ignoring config cache: %v", err))
+
+		return
+	}
+
+	cs.applyConfigsProto(configs)
+
+	cs.Lock()
+	if highWatermark > cs.highWatermark {
+		cs.highWatermark = highWatermark
+	}
+	cs.Unlock()
+
+	slog.Debug(fmt.Sprintf("Loaded %d configs from cache %s", len(configs.GetConfigs()), cs.cache.path))
+	cs.markLoaded()
+}
+
 func (cs *APIConfigStore) GetContextValue(propertyName string) (interface{}, bool) {
-	value, valueExists := cs.contextSet.GetContextValue(propertyName)
+	cs.RLock()
+	contextSet := cs.contextSet
+	cs.RUnlock()
+
+	value, valueExists := contextSet.GetContextValue(propertyName)
 
 	return value, valueExists
 }
@@ -96,20 +255,24 @@ func (cs *APIConfigStore) Keys() []string {
 	return keys
 }
 
-func (cs *APIConfigStore) setConfig(newConfig *prefabProto.Config) {
+func (cs *APIConfigStore) setConfig(newConfig *prefabProto.Config) (ConfigChange, bool) {
 	newConfigIsEmpty := len(newConfig.GetRows()) == 0
 	currentConfig, exists := cs.configMap[newConfig.GetKey()]
 
+	if newConfig.GetId() > cs.highWatermark {
+		cs.highWatermark = newConfig.GetId()
+	}
+
 	switch {
 	case newConfigIsEmpty && exists && newConfig.GetId() > currentConfig.GetId():
 		delete(cs.configMap, newConfig.GetKey())
-	case !newConfigIsEmpty && (exists && newConfig.GetId() > currentConfig.GetId()) || (!exists):
+	case !newConfigIsEmpty && (!exists || newConfig.GetId() > currentConfig.GetId()):
 		cs.configMap[newConfig.GetKey()] = newConfig
+	default:
+		return ConfigChange{}, false
 	}
 
-	if newConfig.GetId() > cs.highWatermark {
-		cs.highWatermark = newConfig.GetId()
-	}
+	return ConfigChange{Key: newConfig.GetKey(), Old: currentConfig, New: newConfig}, true
 }
 
 // GetConfig retrieves a Config associated with the given key.
@@ -124,6 +287,14 @@ func (cs *APIConfigStore) GetConfig(key string) (*prefabProto.Config, bool) {
 	return config, exists
 }
 
+// CopyConfigs returns a copy of the configs map, see ConfigCopier.
+func (cs *APIConfigStore) CopyConfigs() map[string]*prefabProto.Config {
+	cs.RLock()
+	defer cs.RUnlock()
+
+	return maps.Clone(cs.configMap)
+}
+
 func (cs *APIConfigStore) GetProjectEnvID() int64 {
 	cs.RLock()
 	defer cs.RUnlock()
@@ -132,7 +303,7 @@ func (cs *APIConfigStore) GetProjectEnvID() int64 {
 }
 
 func (cs *APIConfigStore) fetchFromServer(retriesAttempted int, then func()) error {
-	configs, err := cs.httpClient.Load(cs.highWatermark)
+	configs, err := cs.httpClient.Load(cs.GetHighWatermark())
 	if err != nil {
 		slog.Warn(fmt.Sprintf("unable to get data via http %v", err))
 
@@ -141,7 +312,11 @@ func (cs *APIConfigStore) fetchFromServer(retriesAttempted int, then func()) err
 
 			slog.Debug(fmt.Sprintf("retrying in %d seconds, attempt %d/%d", int(retryDelay.Seconds()), retriesAttempted+1, maxRetries))
 
-			time.Sleep(retryDelay)
+			select {
+			case <-time.After(retryDelay):
+			case <-cs.ctx.Done():
+				return cs.ctx.Err()
+			}
 
 			err = cs.fetchFromServer(retriesAttempted+1, then)
 			if err != nil {
@@ -157,19 +332,44 @@ func (cs *APIConfigStore) fetchFromServer(retriesAttempted int, then func()) err
 		return nil
 	}
 
+	if cs.ctx.Err() != nil {
+		return cs.ctx.Err()
+	}
+
 	slog.Debug("Loaded configuration data")
 	cs.SetFromConfigsProto(configs)
 
-	cs.finishedLoading()
+	cs.markLoaded()
 
 	then()
 
 	return nil
 }
 
+// markLoaded signals finishedLoading after the first successful load, whichever path it came from.
+func (cs *APIConfigStore) markLoaded() {
+	cs.loadedOnce.Do(cs.finishedLoading)
+}
+
+// DefaultContext returns the default context sent by the API with the last batch.
+func (cs *APIConfigStore) DefaultContext() *prefabProto.ContextSet {
+	cs.RLock()
+	defer cs.RUnlock()
+
+	return cs.defaultContext
+}
+
 func (cs *APIConfigStore) GetHighWatermark() int64 {
 	cs.RLock()
 	defer cs.RUnlock()
 
 	return cs.highWatermark
 }
+
+// Close stops the initial fetch retries and the SSE connection or polling. Configs
+// already loaded remain readable.
+func (cs *APIConfigStore) Close() error {
+	cs.cancel()
+
+	return nil
+}
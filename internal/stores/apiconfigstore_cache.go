@@ -0,0 +1,132 @@
+package stores
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+const configCacheVersion = 1
+
+// configCache persists the last known good configs so a cold start can serve
+// them when the API is unreachable. Each SDK key gets its own file, named by
+// a hash of the key so the key itself isn't written to disk.
+type configCache struct {
+	path  string
+	mutex sync.Mutex
+}
+
+type configCacheFile struct {
+	Version       int    `json:"version"`
+	HighWatermark int64  `json:"highWatermark"`
+	Checksum      string `json:"checksum"`
+	Configs       []byte `json:"configs"`
+}
+
+func newConfigCache(dir string, sdkKey string) *configCache {
+	keyHash := sha256.Sum256([]byte(sdkKey))
+
+	return &configCache{path: filepath.Join(dir, "reforge-configs-"+hex.EncodeToString(keyHash[:8])+".json")}
+}
+
+// load returns the cached configs and high watermark. It fails if the file is
+// missing, from another cache version, or doesn't match its checksum.
+func (c *configCache) load() (*prefabProto.Configs, int64, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var file configCacheFile
+
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, 0, fmt.Errorf("config cache %s is corrupt: %w", c.path, err)
+	}
+
+	if file.Version != configCacheVersion {
+		return nil, 0, fmt.Errorf("config cache %s has unsupported version %d", c.path, file.Version)
+	}
+
+	if checksum(file.Configs) != file.Checksum {
+		return nil, 0, fmt.Errorf("config cache %s failed its checksum", c.path)
+	}
+
+	var configs prefabProto.Configs
+
+	if err := proto.Unmarshal(file.Configs, &configs); err != nil {
+		return nil, 0, fmt.Errorf("config cache %s is corrupt: %w", c.path, err)
+	}
+
+	return &configs, file.HighWatermark, nil
+}
+
+// save replaces the cache file atomically, so a crash mid-write leaves the
+// previous snapshot in place.
+func (c *configCache) save(configs *prefabProto.Configs, highWatermark int64) (err error) {
+	data, err := proto.Marshal(configs)
+	if err != nil {
+		return err
+	}
+
+	contents, err := json.Marshal(configCacheFile{
+		Version:       configCacheVersion,
+		HighWatermark: highWatermark,
+		Checksum:      checksum(data),
+		Configs:       data,
+	})
+	if err != nil {
+		return err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	dir := filepath.Dir(c.path)
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(c.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, os.Remove(tmp.Name()))
+		}
+	}()
+
+	if _, err := tmp.Write(contents); err != nil {
+		return errors.Join(err, tmp.Close())
+	}
+
+	if err := tmp.Sync(); err != nil {
+		return errors.Join(err, tmp.Close())
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), c.path)
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,152 @@
+package stores_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	opts "github.com/ReforgeHQ/sdk-go/internal/options"
+	"github.com/ReforgeHQ/sdk-go/internal/stores"
+	"github.com/ReforgeHQ/sdk-go/internal/testutils"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+func TestApiConfigStoreCache(t *testing.T) {
+	cacheDir := t.TempDir()
+
+	var (
+		mutex    sync.Mutex
+		requests []string
+		down     bool
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		requests = append(requests, r.URL.Path)
+
+		if down {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		body, err := proto.Marshal(&prefabProto.Configs{
+			Configs: []*prefabProto.Config{{
+				Key:  "foo",
+				Id:   42,
+				Rows: []*prefabProto.ConfigRow{{Values: []*prefabProto.ConditionalValue{{Value: testutils.CreateConfigValueAndAssertOk(t, "cached")}}}},
+			}},
+			ConfigServicePointer: &prefabProto.ConfigServicePointer{ProjectEnvId: 7},
+		})
+		assert.NoError(t, err)
+
+		_, err = w.Write(body)
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	options := opts.Options{APIURLs: []string{server.URL}, SdkKey: "test-key", PollingInterval: time.Hour, CacheDir: cacheDir}
+
+	newStore := func() (*stores.APIConfigStore, chan struct{}) {
+		loaded := make(chan struct{})
+
+		store, err := stores.NewAPIConfigStore(options, func() { close(loaded) }, nil, nil, nil)
+		require.NoError(t, err)
+
+		t.Cleanup(func() { store.Close() })
+
+		return store, loaded
+	}
+
+	// A successful load writes the cache
+	_, loaded := newStore()
+	<-loaded
+
+	require.Eventually(t, func() bool {
+		files, _ := filepath.Glob(filepath.Join(cacheDir, "*.json"))
+
+		return len(files) == 1
+	}, time.Second, 5*time.Millisecond)
+
+	// With the API down, the next store starts from the cache and resumes from its high watermark
+	mutex.Lock()
+	down = true
+	requests = nil
+	mutex.Unlock()
+
+	store, loaded := newStore()
+
+	select {
+	case <-loaded:
+	default:
+		t.Fatal("the cache should finish loading before NewAPIConfigStore returns")
+	}
+
+	config, exists := store.GetConfig("foo")
+	require.True(t, exists)
+	assert.Equal(t, int64(42), config.GetId())
+	assert.Equal(t, int64(7), store.GetProjectEnvID())
+	assert.Equal(t, int64(42), store.GetHighWatermark())
+
+	require.Eventually(t, func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		return len(requests) > 0
+	}, time.Second, 5*time.Millisecond)
+
+	mutex.Lock()
+	assert.Equal(t, "/api/v2/configs/42", requests[0])
+	mutex.Unlock()
+}
+
+func TestApiConfigStoreIgnoresCorruptCache(t *testing.T) {
+	cacheDir := t.TempDir()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	// Write a valid cache, then tamper with it
+	options := opts.Options{APIURLs: []string{server.URL}, SdkKey: "test-key", PollingInterval: time.Hour, CacheDir: cacheDir}
+
+	store, err := stores.NewAPIConfigStore(options, func() {}, nil, nil, nil)
+	require.NoError(t, err)
+
+	store.SetFromConfigsProto(&prefabProto.Configs{Configs: []*prefabProto.Config{{
+		Key:  "foo",
+		Id:   1,
+		Rows: []*prefabProto.ConfigRow{{Values: []*prefabProto.ConditionalValue{{Value: testutils.CreateConfigValueAndAssertOk(t, "v")}}}},
+	}}})
+	store.Close()
+
+	files, _ := filepath.Glob(filepath.Join(cacheDir, "*.json"))
+	require.Len(t, files, 1)
+
+	contents, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(files[0], append(contents[:len(contents)-10], []byte(`"bogus"}`)...), 0o600))
+
+	loaded := false
+
+	store, err = stores.NewAPIConfigStore(options, func() { loaded = true }, nil, nil, nil)
+	require.NoError(t, err)
+
+	defer store.Close()
+
+	assert.False(t, loaded)
+
+	_, exists := store.GetConfig("foo")
+	assert.False(t, exists)
+}
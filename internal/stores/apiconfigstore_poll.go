@@ -0,0 +1,67 @@
+package stores
+
+import (
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+)
+
+const (
+	pollJitterFraction = 0.1
+	maxPollBackoff     = 5 * time.Minute
+)
+
+// poll loads the changes since the high watermark every interval until the
+// store is closed. The interval is jittered so a fleet of clients doesn't
+// poll in lockstep, and doubles after each consecutive failure up to
+// maxPollBackoff (or the interval, if that is longer).
+func (cs *APIConfigStore) poll(interval time.Duration) {
+	failures := 0
+
+	for {
+		select {
+		case <-time.After(pollDelay(interval, failures)):
+		case <-cs.ctx.Done():
+			return
+		}
+
+		configs, err := cs.httpClient.Load(cs.GetHighWatermark())
+		if err != nil {
+			failures++
+
+			slog.Warn(fmt.Sprintf("unable to poll for config updates (%d consecutive failures): %v", failures, err))
+
+			continue
+		}
+
+		failures = 0
+
+		if cs.ctx.Err() != nil {
+			return
+		}
+
+		cs.SetFromConfigsProto(configs)
+		cs.markLoaded()
+	}
+}
+
+func pollDelay(interval time.Duration, failures int) time.Duration {
+	delay := interval
+
+	for range failures {
+		if delay >= maxPollBackoff {
+			break
+		}
+
+		delay *= 2
+	}
+
+	if delay > maxPollBackoff && interval < maxPollBackoff {
+		delay = maxPollBackoff
+	}
+
+	jitter := (rand.Float64()*2 - 1) * pollJitterFraction * float64(delay) // #nosec G404 -- jitter doesn't need a secure source
+
+	return delay + time.Duration(jitter)
+}
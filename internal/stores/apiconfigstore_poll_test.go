@@ -0,0 +1,94 @@
+package stores_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	opts "github.com/ReforgeHQ/sdk-go/internal/options"
+	"github.com/ReforgeHQ/sdk-go/internal/stores"
+	"github.com/ReforgeHQ/sdk-go/internal/testutils"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+func TestApiConfigStorePolling(t *testing.T) {
+	stringConfig := func(id int64, value string) *prefabProto.Config {
+		return &prefabProto.Config{
+			Key: "foo",
+			Id:  id,
+			Rows: []*prefabProto.ConfigRow{{
+				Values: []*prefabProto.ConditionalValue{{Value: testutils.CreateConfigValueAndAssertOk(t, value)}},
+			}},
+		}
+	}
+
+	var (
+		mutex    sync.Mutex
+		requests []string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		requests = append(requests, r.URL.Path)
+		requestNumber := len(requests)
+		mutex.Unlock()
+
+		var configs *prefabProto.Configs
+
+		switch {
+		case requestNumber == 1:
+			configs = &prefabProto.Configs{Configs: []*prefabProto.Config{stringConfig(10, "first")}}
+		case requestNumber == 2:
+			// A failed poll is retried with the same high watermark
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		case strings.HasSuffix(r.URL.Path, "/10"):
+			configs = &prefabProto.Configs{Configs: []*prefabProto.Config{stringConfig(11, "second")}}
+		default:
+			configs = &prefabProto.Configs{ConfigServicePointer: &prefabProto.ConfigServicePointer{ProjectId: 1}}
+		}
+
+		body, err := proto.Marshal(configs)
+		assert.NoError(t, err)
+
+		_, err = w.Write(body)
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	loaded := make(chan struct{}, 10)
+	options := opts.Options{APIURLs: []string{server.URL}, SdkKey: "test-key", PollingInterval: 10 * time.Millisecond}
+
+	store, err := stores.NewAPIConfigStore(options, func() { loaded <- struct{}{} }, nil, nil, nil)
+	require.NoError(t, err)
+
+	defer store.Close()
+
+	select {
+	case <-loaded:
+	case <-time.After(time.Second):
+		t.Fatal("initial load did not finish")
+	}
+
+	require.Eventually(t, func() bool {
+		config, _ := store.GetConfig("foo")
+
+		return config.GetId() == 11
+	}, 2*time.Second, 5*time.Millisecond)
+
+	assert.Equal(t, int64(11), store.GetHighWatermark())
+	assert.Empty(t, loaded, "finishedLoading should only be called once")
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	assert.Equal(t, []string{"/api/v2/configs/0", "/api/v2/configs/10", "/api/v2/configs/10"}, requests[:3])
+}
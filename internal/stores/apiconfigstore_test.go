@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/ReforgeHQ/sdk-go/internal"
@@ -83,7 +84,7 @@ func TestApiConfigStore(t *testing.T) {
 	emptyConfigs := &prefabProto.Configs{}
 
 	t.Run("store initialized after set called and has two values", func(t *testing.T) {
-		store, _ := stores.NewAPIConfigStore(options, func() {})
+		store, _ := stores.NewAPIConfigStore(options, func() {}, nil, nil, nil)
 		store.SetFromConfigsProto(configs)
 		assert.Equal(t, 2, store.Len())
 		assert.True(t, store.Initialized)
@@ -101,7 +102,7 @@ func TestApiConfigStore(t *testing.T) {
 	})
 
 	t.Run("store initialized with empty configs still marked initialized", func(t *testing.T) {
-		store, _ := stores.NewAPIConfigStore(options, func() {})
+		store, _ := stores.NewAPIConfigStore(options, func() {}, nil, nil, nil)
 		store.SetFromConfigsProto(emptyConfigs)
 		assert.Equal(t, 0, store.Len())
 		assert.True(t, store.Initialized)
@@ -113,7 +114,7 @@ func TestApiConfigStore(t *testing.T) {
 	})
 
 	t.Run("updating with tombstoned config foo deletes", func(t *testing.T) {
-		store, _ := stores.NewAPIConfigStore(options, func() {})
+		store, _ := stores.NewAPIConfigStore(options, func() {}, nil, nil, nil)
 		store.SetFromConfigsProto(configs)
 		assert.Equal(t, 2, store.Len())
 		assert.True(t, store.Initialized)
@@ -133,7 +134,7 @@ func TestApiConfigStore(t *testing.T) {
 	})
 
 	t.Run("updating with tombstoned config foo does nothing with smaller id", func(t *testing.T) {
-		store, _ := stores.NewAPIConfigStore(options, func() {})
+		store, _ := stores.NewAPIConfigStore(options, func() {}, nil, nil, nil)
 		store.SetFromConfigsProto(configs)
 		assert.Equal(t, 2, store.Len())
 		assert.True(t, store.Initialized)
@@ -155,7 +156,7 @@ func TestApiConfigStore(t *testing.T) {
 	})
 
 	t.Run("updating with changed config foo does nothing with smaller id", func(t *testing.T) {
-		store, _ := stores.NewAPIConfigStore(options, func() {})
+		store, _ := stores.NewAPIConfigStore(options, func() {}, nil, nil, nil)
 		store.SetFromConfigsProto(configs)
 		assert.Equal(t, 2, store.Len())
 		assert.True(t, store.Initialized)
@@ -177,7 +178,7 @@ func TestApiConfigStore(t *testing.T) {
 	})
 
 	t.Run("updating with changed config foo updates when id is larger", func(t *testing.T) {
-		store, _ := stores.NewAPIConfigStore(options, func() {})
+		store, _ := stores.NewAPIConfigStore(options, func() {}, nil, nil, nil)
 		store.SetFromConfigsProto(configs)
 		assert.Equal(t, 2, store.Len())
 		assert.True(t, store.Initialized)
@@ -194,4 +195,155 @@ func TestApiConfigStore(t *testing.T) {
 		assert.NotNil(t, foo)
 		assert.Equal(t, configFooWithDifferentValue, foo)
 	})
+	t.Run("change handler receives committed changes only", func(t *testing.T) {
+		var batches [][]stores.ConfigChange
+
+		store, _ := stores.NewAPIConfigStore(options, func() {}, func(changes []stores.ConfigChange) {
+			batches = append(batches, changes)
+		}, nil, nil)
+
+		store.SetFromConfigsProto(configs)
+		assert.Len(t, batches, 1)
+		assert.ElementsMatch(t, []stores.ConfigChange{
+			{Key: "foo", Old: nil, New: configFoo},
+			{Key: "bar", Old: nil, New: configBar},
+		}, batches[0])
+
+		// stale update is not committed, so nothing is reported
+		store.SetFromConfigsProto(configs)
+		assert.Len(t, batches, 1)
+
+		store.SetFromConfigsProto(&prefabProto.Configs{Configs: []*prefabProto.Config{configFooWithDifferentValue}})
+		assert.Len(t, batches, 2)
+		assert.Equal(t, []stores.ConfigChange{{Key: "foo", Old: configFoo, New: configFooWithDifferentValue}}, batches[1])
+
+		store.SetFromConfigsProto(&prefabProto.Configs{Configs: []*prefabProto.Config{proto.Clone(configFooTombstone).(*prefabProto.Config)}})
+		assert.Len(t, batches, 2, "tombstone with the same id as the current config is ignored")
+
+		newerTombstone := proto.Clone(configFooTombstone).(*prefabProto.Config)
+		newerTombstone.Id = 12
+		store.SetFromConfigsProto(&prefabProto.Configs{Configs: []*prefabProto.Config{newerTombstone}})
+		assert.Len(t, batches, 3)
+		assert.Equal(t, []stores.ConfigChange{{Key: "foo", Old: configFooWithDifferentValue, New: newerTombstone}}, batches[2])
+	})
+
+	t.Run("tombstone for an unknown key is ignored", func(t *testing.T) {
+		var batches [][]stores.ConfigChange
+
+		store, _ := stores.NewAPIConfigStore(options, func() {}, func(changes []stores.ConfigChange) {
+			batches = append(batches, changes)
+		}, nil, nil)
+
+		store.SetFromConfigsProto(&prefabProto.Configs{Configs: []*prefabProto.Config{configBar}})
+		assert.Len(t, batches, 1)
+
+		store.SetFromConfigsProto(&prefabProto.Configs{Configs: []*prefabProto.Config{proto.Clone(configFooTombstone).(*prefabProto.Config)}})
+		assert.Len(t, batches, 1, "no change is reported")
+		assert.Equal(t, 1, store.Len())
+
+		_, fooExists := store.GetConfig("foo")
+		assert.False(t, fooExists)
+	})
+}
+
+func TestApiConfigStoreSchemaValidation(t *testing.T) {
+	options := opts.Options{APIURLs: []string{"https://api.reforge.com"}}
+
+	schema := &prefabProto.Config{
+		Key:        "retry.schema",
+		Id:         1,
+		ConfigType: prefabProto.ConfigType_SCHEMA,
+		Rows: []*prefabProto.ConfigRow{{Values: []*prefabProto.ConditionalValue{{
+			Value: &prefabProto.ConfigValue{Type: &prefabProto.ConfigValue_Schema{Schema: &prefabProto.Schema{
+				SchemaType: prefabProto.Schema_JSON_SCHEMA,
+				Schema:     `{"type": "object", "required": ["attempts"], "properties": {"attempts": {"type": "integer", "minimum": 1}}}`,
+			}}},
+		}}}},
+	}
+
+	retryPolicy := func(id int64, values ...string) *prefabProto.Config {
+		config := &prefabProto.Config{Key: "retry.policy", Id: id, SchemaKey: internal.StringPtr("retry.schema"), Rows: []*prefabProto.ConfigRow{{}}}
+
+		for _, value := range values {
+			config.Rows[0].Values = append(config.Rows[0].Values, &prefabProto.ConditionalValue{
+				Value: &prefabProto.ConfigValue{Type: &prefabProto.ConfigValue_Json{Json: &prefabProto.Json{Json: value}}},
+			})
+		}
+
+		return config
+	}
+
+	var (
+		violations []stores.SchemaViolation
+		batches    [][]stores.ConfigChange
+	)
+
+	store, _ := stores.NewAPIConfigStore(options, func() {}, func(changes []stores.ConfigChange) {
+		batches = append(batches, changes)
+	}, nil, func(rejected []stores.SchemaViolation) {
+		violations = append(violations, rejected...)
+	})
+
+	t.Run("a new key with an invalid value is not stored", func(t *testing.T) {
+		store.SetFromConfigsProto(&prefabProto.Configs{Configs: []*prefabProto.Config{schema, retryPolicy(2, `{"attempts": 0}`)}})
+
+		_, exists := store.GetConfig("retry.policy")
+		assert.False(t, exists)
+		assert.Len(t, violations, 1)
+		assert.Equal(t, "retry.policy", violations[0].Key)
+		assert.Equal(t, "retry.schema", violations[0].SchemaKey)
+		assert.Len(t, batches[0], 1, "only the schema is reported as a change")
+	})
+
+	t.Run("valid values are stored", func(t *testing.T) {
+		store.SetFromConfigsProto(&prefabProto.Configs{Configs: []*prefabProto.Config{retryPolicy(3, `{"attempts": 3}`)}})
+
+		config, exists := store.GetConfig("retry.policy")
+		assert.True(t, exists)
+		assert.Equal(t, int64(3), config.GetId())
+		assert.Len(t, violations, 1)
+	})
+
+	t.Run("an invalid update keeps the last valid version", func(t *testing.T) {
+		store.SetFromConfigsProto(&prefabProto.Configs{Configs: []*prefabProto.Config{retryPolicy(4, `{"attempts": 5}`, `{"tries": 5}`)}})
+
+		config, _ := store.GetConfig("retry.policy")
+		assert.Equal(t, int64(3), config.GetId())
+		assert.Len(t, violations, 2)
+		assert.ErrorContains(t, violations[1].Err, "row 0 value 1")
+		assert.Len(t, batches, 2, "the rejected update is not reported as a change")
+	})
+
+	t.Run("the schema and its values can change in one batch", func(t *testing.T) {
+		relaxed := proto.Clone(schema).(*prefabProto.Config)
+		relaxed.Id = 5
+		relaxed.Rows[0].Values[0].Value.GetSchema().Schema = `{"type": "object"}`
+
+		store.SetFromConfigsProto(&prefabProto.Configs{Configs: []*prefabProto.Config{retryPolicy(6, `{"tries": 5}`), relaxed}})
+
+		config, _ := store.GetConfig("retry.policy")
+		assert.Equal(t, int64(6), config.GetId())
+		assert.Len(t, violations, 2)
+	})
+
+	t.Run("a schema change rechecks the values using it", func(t *testing.T) {
+		strict := proto.Clone(schema).(*prefabProto.Config)
+		strict.Id = 7
+
+		store.SetFromConfigsProto(&prefabProto.Configs{Configs: []*prefabProto.Config{strict}})
+
+		_, exists := store.GetConfig("retry.policy")
+		assert.False(t, exists, "no version of the key matches the new schema")
+		require.Len(t, violations, 3)
+		assert.Equal(t, int64(6), violations[2].Config.GetId())
+
+		lastBatch := batches[len(batches)-1]
+		require.Len(t, lastBatch, 2)
+
+		for _, change := range lastBatch {
+			if change.Key == "retry.policy" {
+				assert.Empty(t, change.New.GetRows(), "the dropped key is reported as deleted")
+			}
+		}
+	})
 }
@@ -31,6 +31,29 @@ func (s *CompositeConfigStore) GetConfig(key string) (*prefabProto.Config, bool)
 	return nil, false
 }
 
+// GetConfigForContext is GetConfig, asking stores that implement
+// internal.ContextualConfigStoreGetter for the config that applies to contextSet.
+func (s *CompositeConfigStore) GetConfigForContext(key string, contextSet internal.ContextValueGetter) (*prefabProto.Config, bool) {
+	for _, store := range s.stores {
+		var (
+			config *prefabProto.Config
+			exists bool
+		)
+
+		if contextualStore, ok := store.(internal.ContextualConfigStoreGetter); ok {
+			config, exists = contextualStore.GetConfigForContext(key, contextSet)
+		} else {
+			config, exists = store.GetConfig(key)
+		}
+
+		if exists {
+			return config, true
+		}
+	}
+
+	return nil, false
+}
+
 func (s *CompositeConfigStore) GetContextValue(propertyName string) (interface{}, bool) {
 	for _, store := range s.stores {
 		value, valueExists := store.GetContextValue(propertyName)
@@ -0,0 +1,16 @@
+package stores
+
+import prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+
+// ConfigChange describes a single committed change to a store's config map.
+// Old is nil when the key is new. For a tombstone, New is the tombstone
+// config (no rows) and the key has been removed from the store.
+type ConfigChange struct {
+	Old *prefabProto.Config
+	New *prefabProto.Config
+	Key string
+}
+
+// ConfigChangeHandler is called by a store after it commits a batch of
+// updates. It is called without the store's lock held.
+type ConfigChangeHandler func(changes []ConfigChange)
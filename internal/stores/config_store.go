@@ -5,16 +5,34 @@ import (
 
 	"github.com/ReforgeHQ/sdk-go/internal"
 	opts "github.com/ReforgeHQ/sdk-go/internal/options"
+	"github.com/ReforgeHQ/sdk-go/internal/sse"
 )
 
-func BuildConfigStore(options opts.Options, source opts.ConfigSource, apiSourceFinishedLoading func()) (internal.ConfigStoreGetter, bool, error) {
+func BuildConfigStore(options opts.Options, source opts.ConfigSource, apiSourceFinishedLoading func(), onChange ConfigChangeHandler, onStreamState sse.ConnectionStateHandler, onViolation SchemaViolationHandler) (internal.ConfigStoreGetter, bool, error) {
 	switch source.Store {
 	case opts.APIStore:
-		store, err := NewAPIConfigStore(options, apiSourceFinishedLoading)
+		store, err := NewAPIConfigStore(options, apiSourceFinishedLoading, onChange, onStreamState, onViolation)
+
+		return store, true, err
+	case opts.Poll:
+		switch {
+		case source.PollingInterval > 0:
+			options.PollingInterval = source.PollingInterval
+		case options.PollingInterval <= 0:
+			options.PollingInterval = opts.DefaultPollingInterval
+		}
+
+		store, err := NewAPIConfigStore(options, apiSourceFinishedLoading, onChange, onStreamState, onViolation)
 
 		return store, true, err
 	case opts.DataFile:
-		store, err := NewLocalConfigStore(source.Path)
+		if options.DatafileReloadInterval <= 0 {
+			store, err := NewLocalConfigStore(source.Path, options.EnvironmentNames...)
+
+			return store, false, err
+		}
+
+		store, err := NewWatchedLocalConfigStore(source.Path, options.EnvironmentNames, options.DatafileReloadInterval, onChange, onViolation)
 
 		return store, false, err
 	case opts.Memory:
@@ -4,8 +4,14 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
 
 	"github.com/ReforgeHQ/sdk-go/internal"
 	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
@@ -13,19 +19,231 @@ import (
 
 type LocalConfigStore struct {
 	configMap    map[string]*prefabProto.Config
-	Initialized  bool
+	onChange     ConfigChangeHandler
+	onViolation  SchemaViolationHandler
+	done         chan struct{}
+	paths        []string
+	fileInfos    []os.FileInfo
 	projectEnvID int64
+	closeOnce    sync.Once
+	sync.RWMutex
+	Initialized bool
+}
+
+// NewLocalConfigStore loads path, then the overlay for each environment name
+// in order, with keys in later files replacing earlier ones. Overlays that
+// don't exist are skipped. See OverlayPaths for how they are named.
+//
+// JSON values of configs with a SchemaKey are validated against that schema
+// config, as in NewAPIConfigStore; configs that fail are logged and left out.
+func NewLocalConfigStore(path string, environmentNames ...string) (*LocalConfigStore, error) {
+	paths := OverlayPaths(path, environmentNames)
+
+	configMap, projectEnvID, fileInfos, err := loadLayers(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	logSchemaViolations(rejectSchemaViolations(configMap, nil))
+
+	return &LocalConfigStore{
+		configMap:    configMap,
+		done:         make(chan struct{}),
+		paths:        paths,
+		fileInfos:    fileInfos,
+		projectEnvID: projectEnvID,
+		Initialized:  true,
+	}, nil
+}
+
+// OverlayPaths returns path followed by the overlay for each environment
+// name. "config.yaml" with "production" has the overlay
+// "config.production.yaml"; a ".default." in the file name is replaced
+// instead, so ".prefab.default.config.yaml" has ".prefab.production.config.yaml".
+func OverlayPaths(path string, environmentNames []string) []string {
+	paths := make([]string, 0, len(environmentNames)+1)
+	paths = append(paths, path)
+
+	dir, file := filepath.Split(path)
+
+	for _, environmentName := range environmentNames {
+		var overlay string
+
+		if strings.Contains(file, ".default.") {
+			overlay = strings.Replace(file, ".default.", "."+environmentName+".", 1)
+		} else {
+			extension := filepath.Ext(file)
+			overlay = strings.TrimSuffix(file, extension) + "." + environmentName + extension
+		}
+
+		paths = append(paths, dir+overlay)
+	}
+
+	return paths
 }
 
-func NewLocalConfigStore(path string) (*LocalConfigStore, error) {
+// loadLayers loads each path in turn into one map. Only the first path has
+// to exist; the project env id comes from the last file that sets one.
+func loadLayers(paths []string) (map[string]*prefabProto.Config, int64, []os.FileInfo, error) {
 	configMap := make(map[string]*prefabProto.Config)
+	fileInfos := make([]os.FileInfo, len(paths))
+
+	var projectEnvID int64
+
+	for index, path := range paths {
+		// Stat before reading, so a write that lands mid-read is picked up by the next check
+		fileInfo, err := os.Stat(path)
+		if index > 0 && os.IsNotExist(err) {
+			slog.Debug(fmt.Sprintf("Skipping overlay %s, it does not exist", path))
+
+			continue
+		}
 
-	projectEnvID, err := loadFileIntoMap(path, &configMap)
+		fileInfos[index] = fileInfo
+
+		fileProjectEnvID, err := loadFileIntoMap(path, &configMap)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+
+		if fileProjectEnvID != 0 {
+			projectEnvID = fileProjectEnvID
+		}
+	}
+
+	return configMap, projectEnvID, fileInfos, nil
+}
+
+// NewWatchedLocalConfigStore loads path and its overlays like
+// NewLocalConfigStore, then checks them every interval and reloads them all
+// when any file's modification time, size or identity changes, or an overlay
+// appears or disappears. Checking the file's identity catches the symlink
+// swap Kubernetes uses to update mounted ConfigMaps. Files that fail to parse
+// are logged and the previous configs are kept. A reloaded config that fails
+// schema validation, or whose schema changed and no longer matches, keeps
+// its previous version if that matches and is dropped otherwise; either way it
+// is passed to onViolation. onChange and onViolation may be nil.
+func NewWatchedLocalConfigStore(path string, environmentNames []string, interval time.Duration, onChange ConfigChangeHandler, onViolation SchemaViolationHandler) (*LocalConfigStore, error) {
+	store, err := NewLocalConfigStore(path, environmentNames...)
 	if err != nil {
 		return nil, err
 	}
 
-	return &LocalConfigStore{configMap: configMap, projectEnvID: projectEnvID, Initialized: true}, nil
+	store.onChange = onChange
+	store.onViolation = onViolation
+
+	go store.watch(interval)
+
+	return store, nil
+}
+
+func (s *LocalConfigStore) watch(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.reloadIfChanged()
+		case <-s.done:
+			return
+		}
+	}
+}
+
+func (s *LocalConfigStore) reloadIfChanged() {
+	changed := false
+
+	for index, path := range s.paths {
+		fileInfo, err := os.Stat(path)
+		if err != nil && (index == 0 || !os.IsNotExist(err)) {
+			slog.Warn(fmt.Sprintf("unable to check datafile %s for changes: %v", path, err))
+
+			return
+		}
+
+		if !sameFileVersion(s.fileInfos[index], fileInfo) {
+			changed = true
+		}
+	}
+
+	if !changed {
+		return
+	}
+
+	configMap, projectEnvID, fileInfos, err := loadLayers(s.paths)
+	if err != nil {
+		slog.Error(fmt.Sprintf("unable to reload datafile %s, keeping the previous configs: %v", s.paths[0], err))
+
+		// Don't retry until a file changes again
+		for index, path := range s.paths {
+			s.fileInfos[index], _ = os.Stat(path)
+		}
+
+		return
+	}
+
+	s.fileInfos = fileInfos
+
+	slog.Debug(fmt.Sprintf("Reloaded datafile %s", s.paths[0]))
+
+	changes, violations := s.swap(configMap, projectEnvID)
+
+	logSchemaViolations(violations)
+
+	if s.onViolation != nil && len(violations) > 0 {
+		s.onViolation(violations)
+	}
+
+	if s.onChange != nil && len(changes) > 0 {
+		s.onChange(changes)
+	}
+}
+
+func sameFileVersion(previous os.FileInfo, current os.FileInfo) bool {
+	if previous == nil || current == nil {
+		return previous == nil && current == nil
+	}
+
+	return os.SameFile(previous, current) && previous.ModTime().Equal(current.ModTime()) && previous.Size() == current.Size()
+}
+
+// swap replaces the config map, less the configs rejected by schema
+// validation, and returns what changed and what was rejected. Keys missing
+// from the new map are reported as tombstones.
+func (s *LocalConfigStore) swap(configMap map[string]*prefabProto.Config, projectEnvID int64) ([]ConfigChange, []SchemaViolation) {
+	s.Lock()
+	defer s.Unlock()
+
+	violations := rejectSchemaViolations(configMap, s.configMap)
+
+	var changes []ConfigChange
+
+	for key, newConfig := range configMap {
+		if oldConfig, exists := s.configMap[key]; !exists || !proto.Equal(oldConfig, newConfig) {
+			changes = append(changes, ConfigChange{Key: key, Old: oldConfig, New: newConfig})
+		}
+	}
+
+	for key, oldConfig := range s.configMap {
+		if _, exists := configMap[key]; !exists {
+			changes = append(changes, ConfigChange{Key: key, Old: oldConfig, New: &prefabProto.Config{Key: key}})
+		}
+	}
+
+	s.configMap = configMap
+	s.projectEnvID = projectEnvID
+
+	return changes, violations
+}
+
+// Close stops watching the file. Configs already loaded remain readable.
+func (s *LocalConfigStore) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.done)
+	})
+
+	return nil
 }
 
 func parserFor(filePath string) (internal.ConfigParser, error) {
@@ -67,12 +285,26 @@ func loadFileIntoMap(filePath string, configmap *map[string]*prefabProto.Config)
 }
 
 func (s *LocalConfigStore) GetConfig(key string) (*prefabProto.Config, bool) {
+	s.RLock()
+	defer s.RUnlock()
+
 	config, exists := s.configMap[key]
 
 	return config, exists
 }
 
+// CopyConfigs returns a copy of the configs map, see ConfigCopier.
+func (s *LocalConfigStore) CopyConfigs() map[string]*prefabProto.Config {
+	s.RLock()
+	defer s.RUnlock()
+
+	return maps.Clone(s.configMap)
+}
+
 func (s *LocalConfigStore) Keys() []string {
+	s.RLock()
+	defer s.RUnlock()
+
 	keys := make([]string, 0, len(s.configMap))
 	for key := range s.configMap {
 		keys = append(keys, key)
@@ -82,6 +314,9 @@ func (s *LocalConfigStore) Keys() []string {
 }
 
 func (s *LocalConfigStore) GetProjectEnvID() int64 {
+	s.RLock()
+	defer s.RUnlock()
+
 	return s.projectEnvID
 }
 
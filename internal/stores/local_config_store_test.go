@@ -93,6 +93,39 @@ func (suite *LocalConfigStoreSuite) TestNewLocalConfigStore() {
 	}
 }
 
+func (suite *LocalConfigStoreSuite) TestNewLocalConfigStoreWithEnvironmentOverlays() {
+	store, err := stores.NewLocalConfigStore("testdata/local_configs/.prefab.default.config.yaml", "production", "missing")
+	suite.Require().NoError(err)
+
+	expectations := []configExpectation{
+		{key: "cool.bool.enabled", expected: testutils.CreateConfigValueAndAssertOk(suite.T(), false)},
+		{key: "hot.int", expected: testutils.CreateConfigValueAndAssertOk(suite.T(), 212)},
+		{key: "sample_to_override", expected: testutils.CreateConfigValueAndAssertOk(suite.T(), "value from override in production")},
+		// Only in default, kept under the overlay
+		{key: "cool.count", expected: testutils.CreateConfigValueAndAssertOk(suite.T(), 100)},
+	}
+
+	for _, expectation := range expectations {
+		config, exists := store.GetConfig(expectation.key)
+		suite.Require().Truef(exists, "Expected config with key '%s' to exist", expectation.key)
+
+		value, onlyValue := suite.onlyValue(config)
+		suite.Require().True(onlyValue)
+		suite.Equal(expectation.expected, value, "key %s", expectation.key)
+	}
+}
+
+func (suite *LocalConfigStoreSuite) TestOverlayPaths() {
+	suite.Equal(
+		[]string{"dir/config.yaml", "dir/config.production.yaml", "dir/config.production.eu.yaml"},
+		stores.OverlayPaths("dir/config.yaml", []string{"production", "production.eu"}),
+	)
+	suite.Equal(
+		[]string{".prefab.default.config.yaml", ".prefab.staging.config.yaml"},
+		stores.OverlayPaths(".prefab.default.config.yaml", []string{"staging"}),
+	)
+}
+
 func (suite *LocalConfigStoreSuite) onlyValue(config *prefabProto.Config) (*prefabProto.ConfigValue, bool) {
 	if len(config.GetRows()) != 1 {
 		return nil, false
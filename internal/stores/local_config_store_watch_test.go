@@ -0,0 +1,204 @@
+package stores_test
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ReforgeHQ/sdk-go/internal"
+	"github.com/ReforgeHQ/sdk-go/internal/options"
+	"github.com/ReforgeHQ/sdk-go/internal/stores"
+)
+
+func TestWatchedLocalConfigStoreReloadsChangedFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "configs.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("greeting: hello\nremoved: soon\n"), 0o600))
+
+	var (
+		mutex   sync.Mutex
+		batches [][]stores.ConfigChange
+	)
+
+	store, err := stores.NewWatchedLocalConfigStore(path, nil, 5*time.Millisecond, func(changes []stores.ConfigChange) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		batches = append(batches, changes)
+	}, nil)
+	require.NoError(t, err)
+
+	defer store.Close()
+
+	// ConfigMap updates swap in a new file rather than writing in place
+	replace := func(contents string) {
+		tmp := path + ".tmp"
+		require.NoError(t, os.WriteFile(tmp, []byte(contents), 0o600))
+		require.NoError(t, os.Rename(tmp, path))
+	}
+
+	replace("greeting: hi\n")
+
+	require.Eventually(t, func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		return len(batches) == 1
+	}, time.Second, 5*time.Millisecond)
+
+	config, exists := store.GetConfig("greeting")
+	require.True(t, exists)
+	assert.Equal(t, "hi", config.GetRows()[0].GetValues()[0].GetValue().GetString_())
+
+	_, exists = store.GetConfig("removed")
+	assert.False(t, exists)
+
+	mutex.Lock()
+	changesByKey := map[string]stores.ConfigChange{}
+	for _, change := range batches[0] {
+		changesByKey[change.Key] = change
+	}
+	mutex.Unlock()
+
+	require.Len(t, changesByKey, 2)
+	assert.NotNil(t, changesByKey["greeting"].Old)
+	assert.Empty(t, changesByKey["removed"].New.GetRows(), "removed keys are reported as tombstones")
+
+	// A file that fails to parse keeps the previous configs
+	replace("greeting: [unterminated\n")
+
+	time.Sleep(50 * time.Millisecond)
+
+	config, exists = store.GetConfig("greeting")
+	require.True(t, exists)
+	assert.Equal(t, "hi", config.GetRows()[0].GetValues()[0].GetValue().GetString_())
+
+	mutex.Lock()
+	assert.Len(t, batches, 1)
+	mutex.Unlock()
+}
+
+func TestWatchedLocalConfigStoreReloadsWhenAnOverlayAppears(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("greeting: hello\n"), 0o600))
+
+	store, err := stores.NewWatchedLocalConfigStore(path, []string{"production"}, 5*time.Millisecond, nil, nil)
+	require.NoError(t, err)
+
+	defer store.Close()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.production.yaml"), []byte("greeting: hello from production\n"), 0o600))
+
+	require.Eventually(t, func() bool {
+		config, _ := store.GetConfig("greeting")
+
+		return config.GetRows()[0].GetValues()[0].GetValue().GetString_() == "hello from production"
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestBuildConfigStoreWatchesDatafilesOnlyWhenAsked(t *testing.T) {
+	greeting := func(store internal.ConfigStoreGetter) string {
+		config, _ := store.GetConfig("greeting")
+
+		return config.GetRows()[0].GetValues()[0].GetValue().GetString_()
+	}
+
+	for _, interval := range []time.Duration{0, 5 * time.Millisecond} {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("greeting: hello\n"), 0o600))
+
+		source := options.ConfigSource{Store: options.DataFile, Path: path}
+
+		store, _, err := stores.BuildConfigStore(options.Options{DatafileReloadInterval: interval}, source, nil, nil, nil, nil)
+		require.NoError(t, err)
+
+		defer store.(*stores.LocalConfigStore).Close()
+
+		require.NoError(t, os.WriteFile(path, []byte("greeting: hi there\n"), 0o600))
+
+		if interval == 0 {
+			time.Sleep(50 * time.Millisecond)
+			assert.Equal(t, "hello", greeting(store), "datafiles are loaded once by default")
+
+			continue
+		}
+
+		require.Eventually(t, func() bool {
+			return greeting(store) == "hi there"
+		}, time.Second, 5*time.Millisecond)
+	}
+}
+
+func TestWatchedLocalConfigStoreValidatesSchemas(t *testing.T) {
+	datafile := func(attempts string) string {
+		return `{
+  "configServicePointer": {"projectEnvId": "1"},
+  "configs": [
+    {
+      "key": "retry.schema",
+      "configType": "SCHEMA",
+      "rows": [{"values": [{"value": {"schema": {"schemaType": "JSON_SCHEMA", "schema": "{\"type\": \"object\", \"properties\": {\"attempts\": {\"type\": \"integer\", \"minimum\": 1}}}"}}}]}]
+    },
+    {
+      "key": "retry.policy",
+      "schemaKey": "retry.schema",
+      "rows": [{"values": [{"value": {"json": {"json": "{\"attempts\": ` + attempts + `}"}}}]}]
+    }
+  ]
+}`
+	}
+
+	path := filepath.Join(t.TempDir(), "configs.json")
+	require.NoError(t, os.WriteFile(path, []byte(datafile("0")), 0o600))
+
+	var (
+		mutex      sync.Mutex
+		violations []stores.SchemaViolation
+	)
+
+	store, err := stores.NewWatchedLocalConfigStore(path, nil, 5*time.Millisecond, nil, func(rejected []stores.SchemaViolation) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		violations = append(violations, rejected...)
+	})
+	require.NoError(t, err)
+
+	defer store.Close()
+
+	attempts := func() string {
+		config, exists := store.GetConfig("retry.policy")
+		if !exists {
+			return ""
+		}
+
+		return config.GetRows()[0].GetValues()[0].GetValue().GetJson().GetJson()
+	}
+
+	_, exists := store.GetConfig("retry.schema")
+	assert.True(t, exists)
+	assert.Empty(t, attempts(), "an invalid value in the initial load is left out")
+
+	require.NoError(t, os.WriteFile(path, []byte(datafile("30")), 0o600))
+	require.Eventually(t, func() bool { return attempts() == `{"attempts": 30}` }, time.Second, 5*time.Millisecond)
+
+	require.NoError(t, os.WriteFile(path, []byte(datafile("-10")), 0o600))
+	require.Eventually(t, func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		return len(violations) == 1
+	}, time.Second, 5*time.Millisecond)
+
+	assert.Equal(t, `{"attempts": 30}`, attempts(), "an invalid reload keeps the last valid version")
+
+	mutex.Lock()
+	assert.Equal(t, "retry.policy", violations[0].Key)
+	assert.Equal(t, "retry.schema", violations[0].SchemaKey)
+	mutex.Unlock()
+}
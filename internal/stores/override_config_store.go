@@ -0,0 +1,125 @@
+package stores
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ReforgeHQ/sdk-go/internal"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+// OverrideConfigStore holds configs set at runtime that take precedence over
+// every other store. A key can have several overrides; the most recent one
+// that hasn't expired and applies to the context wins.
+type OverrideConfigStore struct {
+	overrides map[string][]*override
+	now       func() time.Time
+	nextID    int
+	sync.RWMutex
+}
+
+type override struct {
+	config    *prefabProto.Config
+	expiresAt time.Time
+	when      func(internal.ContextValueGetter) bool
+	id        int
+}
+
+func (o *override) expired(now time.Time) bool {
+	return !o.expiresAt.IsZero() && !now.Before(o.expiresAt)
+}
+
+func NewOverrideConfigStore() *OverrideConfigStore {
+	return &OverrideConfigStore{overrides: make(map[string][]*override), now: time.Now}
+}
+
+// Set adds an override for config's key and returns a func that removes it.
+// A zero expiresAt never expires. A nil when applies to every context.
+func (s *OverrideConfigStore) Set(config *prefabProto.Config, expiresAt time.Time, when func(internal.ContextValueGetter) bool) (remove func()) {
+	s.Lock()
+	defer s.Unlock()
+
+	id := s.nextID
+	s.nextID++
+
+	key := config.GetKey()
+	s.overrides[key] = append(s.overrides[key], &override{config: config, expiresAt: expiresAt, when: when, id: id})
+
+	return func() {
+		s.Lock()
+		defer s.Unlock()
+
+		remaining := s.overrides[key][:0:0]
+
+		for _, existing := range s.overrides[key] {
+			if existing.id != id {
+				remaining = append(remaining, existing)
+			}
+		}
+
+		if len(remaining) == 0 {
+			delete(s.overrides, key)
+		} else {
+			s.overrides[key] = remaining
+		}
+	}
+}
+
+// GetConfig returns the latest override of key that applies to every context.
+func (s *OverrideConfigStore) GetConfig(key string) (*prefabProto.Config, bool) {
+	return s.find(key, func(o *override) bool { return o.when == nil })
+}
+
+// GetConfigForContext returns the latest override of key that applies to contextSet.
+func (s *OverrideConfigStore) GetConfigForContext(key string, contextSet internal.ContextValueGetter) (*prefabProto.Config, bool) {
+	return s.find(key, func(o *override) bool { return o.when == nil || o.when(contextSet) })
+}
+
+func (s *OverrideConfigStore) find(key string, applies func(*override) bool) (*prefabProto.Config, bool) {
+	s.RLock()
+	candidates := s.overrides[key]
+	s.RUnlock()
+
+	now := s.now()
+
+	// Predicates run without the lock, so they may call back into the client
+	for i := len(candidates) - 1; i >= 0; i-- {
+		candidate := candidates[i]
+
+		if candidate.expired(now) {
+			continue
+		}
+
+		if applies(candidate) {
+			return candidate.config, true
+		}
+	}
+
+	return nil, false
+}
+
+// Keys returns the keys with at least one override that hasn't expired.
+func (s *OverrideConfigStore) Keys() []string {
+	s.RLock()
+	defer s.RUnlock()
+
+	now := s.now()
+	keys := make([]string, 0, len(s.overrides))
+
+	for key, overrides := range s.overrides {
+		if slices.ContainsFunc(overrides, func(o *override) bool { return !o.expired(now) }) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+func (s *OverrideConfigStore) GetProjectEnvID() int64 {
+	return 0
+}
+
+func (s *OverrideConfigStore) GetContextValue(_ string) (interface{}, bool) {
+	return nil, false
+}
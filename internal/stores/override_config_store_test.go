@@ -0,0 +1,30 @@
+package stores
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+func TestOverrideConfigStoreSkipsExpiredOverrides(t *testing.T) {
+	store := NewOverrideConfigStore()
+
+	now := time.Now()
+	store.now = func() time.Time { return now }
+
+	store.Set(&prefabProto.Config{Key: "lapsed"}, now.Add(-time.Second), nil)
+	store.Set(&prefabProto.Config{Key: "current"}, now.Add(time.Minute), nil)
+	store.Set(&prefabProto.Config{Key: "forever"}, time.Time{}, nil)
+
+	assert.ElementsMatch(t, []string{"current", "forever"}, store.Keys())
+
+	_, exists := store.GetConfig("lapsed")
+	assert.False(t, exists)
+
+	now = now.Add(time.Minute)
+
+	assert.ElementsMatch(t, []string{"forever"}, store.Keys())
+}
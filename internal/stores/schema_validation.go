@@ -0,0 +1,169 @@
+package stores
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"google.golang.org/protobuf/proto"
+
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+// SchemaViolation describes a config rejected by a store because one of its
+// JSON values doesn't match the schema named by the config's SchemaKey.
+type SchemaViolation struct {
+	// Err describes which value failed and why
+	Err error
+	// Config is the rejected config. The store keeps serving the previous
+	// version of the key if it had one that still matches the schema, and
+	// otherwise drops the key.
+	Config    *prefabProto.Config
+	Key       string
+	SchemaKey string
+}
+
+// SchemaViolationHandler is called by a store after it rejects configs that
+// fail schema validation. It is called without the store's lock held.
+type SchemaViolationHandler func(violations []SchemaViolation)
+
+// schemaURL is the name schemas are compiled under. Schemas are compiled one
+// at a time, so a fixed name is enough.
+const schemaURL = "reforge-schema.json"
+
+// errRemoteSchemaRefs is returned for $refs outside the schema itself, which
+// are not fetched.
+var errRemoteSchemaRefs = errors.New("schemas may not reference other documents")
+
+// rejectSchemaViolations checks the configs in configMap that are new or
+// changed since previous, or whose schema is, against their schema in
+// configMap. A config that fails is replaced by its previous version if that
+// still matches, and removed otherwise. Both stores validate this way, the
+// API store passing its map from before the batch.
+func rejectSchemaViolations(configMap map[string]*prefabProto.Config, previous map[string]*prefabProto.Config) []SchemaViolation {
+	var violations []SchemaViolation
+
+	for key, config := range configMap {
+		schemaKey := config.GetSchemaKey()
+
+		if sameConfig(config, previous[key]) && sameConfig(configMap[schemaKey], previous[schemaKey]) {
+			continue
+		}
+
+		err := matchesSchema(config, configMap)
+		if err == nil {
+			continue
+		}
+
+		if previousConfig, exists := previous[key]; exists && matchesSchema(previousConfig, configMap) == nil {
+			configMap[key] = previousConfig
+		} else {
+			delete(configMap, key)
+		}
+
+		violations = append(violations, SchemaViolation{Err: err, Config: config, Key: key, SchemaKey: schemaKey})
+	}
+
+	return violations
+}
+
+// matchesSchema validates config against its schema in configMap. Configs
+// without a schema, or whose schema isn't in configMap, match.
+func matchesSchema(config *prefabProto.Config, configMap map[string]*prefabProto.Config) error {
+	schemaConfig, hasSchema := configMap[config.GetSchemaKey()]
+	if config.GetSchemaKey() == "" || !hasSchema || len(config.GetRows()) == 0 {
+		return nil
+	}
+
+	return validateSchema(config, schemaConfig)
+}
+
+func sameConfig(config *prefabProto.Config, other *prefabProto.Config) bool {
+	return config == other || proto.Equal(config, other)
+}
+
+func logSchemaViolations(violations []SchemaViolation) {
+	for _, violation := range violations {
+		slog.Warn(fmt.Sprintf("rejected config %s: does not match schema %s: %v", violation.Key, violation.SchemaKey, violation.Err))
+	}
+}
+
+// validateSchema checks every JSON value of config, including weighted
+// values, against schemaConfig's JSON schema. Schema types other than
+// JSON_SCHEMA can't be checked in Go and are accepted.
+func validateSchema(config *prefabProto.Config, schemaConfig *prefabProto.Config) error {
+	schema, ok := schemaOf(schemaConfig)
+	if !ok || schema.GetSchemaType() != prefabProto.Schema_JSON_SCHEMA {
+		return nil
+	}
+
+	compiler := jsonschema.NewCompiler()
+	compiler.LoadURL = func(string) (io.ReadCloser, error) {
+		return nil, errRemoteSchemaRefs
+	}
+
+	if err := compiler.AddResource(schemaURL, strings.NewReader(schema.GetSchema())); err != nil {
+		return fmt.Errorf("schema %s is invalid: %w", schemaConfig.GetKey(), err)
+	}
+
+	compiled, err := compiler.Compile(schemaURL)
+	if err != nil {
+		return fmt.Errorf("schema %s is invalid: %w", schemaConfig.GetKey(), err)
+	}
+
+	for rowIndex, row := range config.GetRows() {
+		for valueIndex, conditionalValue := range row.GetValues() {
+			for _, value := range jsonValues(conditionalValue.GetValue()) {
+				if err := validateJSON(compiled, value); err != nil {
+					return fmt.Errorf("row %d value %d: %w", rowIndex, valueIndex, err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// schemaOf returns the first schema value in config. Schema configs have no
+// targeting, so the first one is the only one.
+func schemaOf(config *prefabProto.Config) (*prefabProto.Schema, bool) {
+	for _, row := range config.GetRows() {
+		for _, conditionalValue := range row.GetValues() {
+			if schema := conditionalValue.GetValue().GetSchema(); schema != nil {
+				return schema, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+func jsonValues(value *prefabProto.ConfigValue) []string {
+	if jsonValue := value.GetJson(); jsonValue != nil {
+		return []string{jsonValue.GetJson()}
+	}
+
+	var values []string
+
+	for _, weightedValue := range value.GetWeightedValues().GetWeightedValues() {
+		values = append(values, jsonValues(weightedValue.GetValue())...)
+	}
+
+	return values
+}
+
+func validateJSON(schema *jsonschema.Schema, value string) error {
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.UseNumber()
+
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	return schema.Validate(decoded)
+}
@@ -0,0 +1,97 @@
+package stores
+
+import (
+	"github.com/ReforgeHQ/sdk-go/internal"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+// ConfigCopier is implemented by stores that can copy every config they hold
+// under a single lock.
+type ConfigCopier interface {
+	CopyConfigs() map[string]*prefabProto.Config
+}
+
+// SnapshotConfigStore is a read-only copy of the configs a store serves, so a
+// batch of evaluations sees one consistent state without locking per key.
+// Context values and the project env id are still read from the source store.
+type SnapshotConfigStore struct {
+	configs map[string]*prefabProto.Config
+	source  internal.ConfigStoreGetter
+}
+
+// Snapshot copies the configs store serves for contextSet. Stores inside a
+// CompositeConfigStore keep their precedence, and stores implementing
+// ConfigCopier are copied in one go.
+func Snapshot(store internal.ConfigStoreGetter, contextSet internal.ContextValueGetter) *SnapshotConfigStore {
+	snapshot := &SnapshotConfigStore{configs: make(map[string]*prefabProto.Config), source: store}
+	snapshot.add(store, contextSet)
+
+	return snapshot
+}
+
+// add copies the configs of store whose keys aren't in the snapshot yet.
+func (s *SnapshotConfigStore) add(store internal.ConfigStoreGetter, contextSet internal.ContextValueGetter) {
+	switch typedStore := store.(type) {
+	case *CompositeConfigStore:
+		for _, innerStore := range typedStore.stores {
+			s.add(innerStore, contextSet)
+		}
+	case ConfigCopier:
+		for key, config := range typedStore.CopyConfigs() {
+			if _, exists := s.configs[key]; !exists {
+				s.configs[key] = config
+			}
+		}
+	default:
+		contextualStore, isContextual := store.(internal.ContextualConfigStoreGetter)
+
+		for _, key := range store.Keys() {
+			if _, exists := s.configs[key]; exists {
+				continue
+			}
+
+			var (
+				config *prefabProto.Config
+				exists bool
+			)
+
+			if isContextual {
+				config, exists = contextualStore.GetConfigForContext(key, contextSet)
+			} else {
+				config, exists = store.GetConfig(key)
+			}
+
+			if exists {
+				s.configs[key] = config
+			}
+		}
+	}
+}
+
+// Configs returns the configs in the snapshot. The map must not be modified.
+func (s *SnapshotConfigStore) Configs() map[string]*prefabProto.Config {
+	return s.configs
+}
+
+func (s *SnapshotConfigStore) GetConfig(key string) (*prefabProto.Config, bool) {
+	config, exists := s.configs[key]
+
+	return config, exists
+}
+
+func (s *SnapshotConfigStore) Keys() []string {
+	keys := make([]string, 0, len(s.configs))
+	for key := range s.configs {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+func (s *SnapshotConfigStore) GetContextValue(propertyName string) (interface{}, bool) {
+	return s.source.GetContextValue(propertyName)
+}
+
+func (s *SnapshotConfigStore) GetProjectEnvID() int64 {
+	return s.source.GetProjectEnvID()
+}
@@ -0,0 +1,49 @@
+package stores
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ReforgeHQ/sdk-go/internal"
+	"github.com/ReforgeHQ/sdk-go/internal/contexts"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+func TestSnapshotKeepsStorePrecedence(t *testing.T) {
+	memory, err := NewMemoryConfigStore(7, map[string]interface{}{"shared": "memory", "memory.only": "memory"})
+	require.NoError(t, err)
+
+	api := &APIConfigStore{configMap: map[string]*prefabProto.Config{
+		"shared":   {Key: "shared", Id: 1},
+		"api.only": {Key: "api.only", Id: 2},
+	}}
+
+	overrides := NewOverrideConfigStore()
+	overrides.Set(&prefabProto.Config{Key: "shared", Id: 3}, time.Time{}, func(contextSet internal.ContextValueGetter) bool {
+		value, _ := contextSet.GetContextValue("user.tier")
+
+		return value == "gold"
+	})
+
+	composite := BuildCompositeConfigStore(overrides, memory, api)
+
+	gold := contexts.NewContextSet().WithNamedContextValues("user", map[string]interface{}{"tier": "gold"})
+	snapshot := Snapshot(composite, gold)
+
+	assert.ElementsMatch(t, []string{"shared", "memory.only", "api.only"}, snapshot.Keys())
+	assert.Equal(t, int64(3), snapshot.Configs()["shared"].GetId(), "the scoped override applies to gold")
+	assert.Equal(t, int64(7), snapshot.GetProjectEnvID())
+
+	silver := contexts.NewContextSet().WithNamedContextValues("user", map[string]interface{}{"tier": "silver"})
+	config, exists := Snapshot(composite, silver).GetConfig("shared")
+	require.True(t, exists)
+	assert.Equal(t, "memory", config.GetRows()[0].GetValues()[0].GetValue().GetString_())
+
+	// Later changes to the stores don't show up in a snapshot already taken
+	api.configMap["api.later"] = &prefabProto.Config{Key: "api.later"}
+	_, exists = snapshot.GetConfig("api.later")
+	assert.False(t, exists)
+}
@@ -0,0 +1,115 @@
+package telemetry
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+// LogRecord is one record logged by LoggerName at Level, as counted by LoggerAggregator
+type LogRecord struct {
+	LoggerName string
+	Level      prefabProto.LogLevel
+}
+
+// LoggerAggregator counts log records per logger name and level so the
+// Reforge UI can list the loggers an application really uses.
+type LoggerAggregator struct {
+	loggers   map[string]*prefabProto.Logger
+	dataStart int64
+	mutex     *sync.Mutex
+}
+
+func NewLoggerAggregator() *LoggerAggregator {
+	return &LoggerAggregator{
+		loggers: make(map[string]*prefabProto.Logger),
+		mutex:   &sync.Mutex{},
+	}
+}
+
+func (la *LoggerAggregator) Lock() {
+	la.mutex.Lock()
+}
+
+func (la *LoggerAggregator) Unlock() {
+	la.mutex.Unlock()
+}
+
+func (la *LoggerAggregator) Record(data interface{}) {
+	record := data.(LogRecord)
+
+	la.Lock()
+	defer la.Unlock()
+
+	if la.dataStart == 0 {
+		la.dataStart = NowProvider()
+	}
+
+	logger, ok := la.loggers[record.LoggerName]
+	if !ok {
+		logger = &prefabProto.Logger{LoggerName: record.LoggerName}
+		la.loggers[record.LoggerName] = logger
+	}
+
+	increment(levelCounter(logger, record.Level))
+}
+
+// levelCounter returns the field of logger counting level. Unknown levels are counted as debug, the level
+// GetLogLevel falls back to.
+func levelCounter(logger *prefabProto.Logger, level prefabProto.LogLevel) **int64 {
+	switch level {
+	case prefabProto.LogLevel_TRACE:
+		return &logger.Traces
+	case prefabProto.LogLevel_INFO:
+		return &logger.Infos
+	case prefabProto.LogLevel_WARN:
+		return &logger.Warns
+	case prefabProto.LogLevel_ERROR:
+		return &logger.Errors
+	case prefabProto.LogLevel_FATAL:
+		return &logger.Fatals
+	default:
+		return &logger.Debugs
+	}
+}
+
+func increment(counter **int64) {
+	if *counter == nil {
+		*counter = new(int64)
+	}
+
+	**counter++
+}
+
+// GetData returns nil when nothing was logged, so no empty event is sent
+func (la *LoggerAggregator) GetData() *prefabProto.TelemetryEvent {
+	if len(la.loggers) == 0 {
+		return nil
+	}
+
+	loggers := make([]*prefabProto.Logger, 0, len(la.loggers))
+	for _, logger := range la.loggers {
+		loggers = append(loggers, logger)
+	}
+
+	slices.SortFunc(loggers, func(a, b *prefabProto.Logger) int {
+		return strings.Compare(a.GetLoggerName(), b.GetLoggerName())
+	})
+
+	return &prefabProto.TelemetryEvent{
+		Payload: &prefabProto.TelemetryEvent_Loggers{
+			Loggers: &prefabProto.LoggersTelemetryEvent{
+				Loggers: loggers,
+				StartAt: la.dataStart,
+				EndAt:   NowProvider(),
+			},
+		},
+	}
+}
+
+func (la *LoggerAggregator) Clear() {
+	la.loggers = make(map[string]*prefabProto.Logger)
+	la.dataStart = 0
+}
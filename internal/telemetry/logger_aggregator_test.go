@@ -0,0 +1,50 @@
+package telemetry_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	integrationtestsupport "github.com/ReforgeHQ/sdk-go/internal/integration_test_support"
+	"github.com/ReforgeHQ/sdk-go/internal/telemetry"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+func TestLoggerAggregator_Record(t *testing.T) {
+	integrationtestsupport.MockNowProvider()
+
+	la := telemetry.NewLoggerAggregator()
+
+	assert.Nil(t, la.GetData(), "nothing logged, nothing to send")
+
+	for range 3 {
+		la.Record(telemetry.LogRecord{LoggerName: "com.example.web", Level: prefabProto.LogLevel_INFO})
+	}
+
+	la.Record(telemetry.LogRecord{LoggerName: "com.example.web", Level: prefabProto.LogLevel_ERROR})
+	la.Record(telemetry.LogRecord{LoggerName: "com.example.db", Level: prefabProto.LogLevel_TRACE})
+	la.Record(telemetry.LogRecord{LoggerName: "com.example.db", Level: prefabProto.LogLevel_NOT_SET_LOG_LEVEL})
+
+	event := la.GetData().GetLoggers()
+	require.NotNil(t, event)
+	assert.Equal(t, int64(1), event.GetStartAt())
+	assert.Equal(t, int64(2), event.GetEndAt())
+
+	require.Len(t, event.GetLoggers(), 2)
+
+	db := event.GetLoggers()[0]
+	assert.Equal(t, "com.example.db", db.GetLoggerName())
+	assert.Equal(t, int64(1), db.GetTraces())
+	assert.Equal(t, int64(1), db.GetDebugs(), "unknown levels count as debug")
+	assert.Nil(t, db.Infos)
+
+	web := event.GetLoggers()[1]
+	assert.Equal(t, "com.example.web", web.GetLoggerName())
+	assert.Equal(t, int64(3), web.GetInfos())
+	assert.Equal(t, int64(1), web.GetErrors())
+	assert.Nil(t, web.Debugs)
+
+	la.Clear()
+	assert.Nil(t, la.GetData())
+}
@@ -2,6 +2,7 @@ package telemetry
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -17,10 +18,7 @@ import (
 	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
 )
 
-var (
-	NowProvider = time.Now().UnixMilli
-	client      = &http.Client{}
-)
+var NowProvider = time.Now().UnixMilli
 
 type QueueItem interface{}
 
@@ -28,11 +26,16 @@ type Submitter struct {
 	aggregators                 []Aggregator
 	contextAggregators          []Aggregator
 	evaluationSummaryAggregator *EvaluationSummaryAggregator
+	loggerAggregator            *LoggerAggregator
 	instanceHash                string
 	host                        string
 	options                     options.Options
+	httpClient                  *http.Client
 	mutex                       *sync.Mutex
 	queue                       chan QueueItem
+	done                        chan struct{}
+	closeOnce                   *sync.Once
+	workers                     *sync.WaitGroup
 }
 
 type Payload = prefabProto.TelemetryEvents
@@ -52,48 +55,129 @@ func NewTelemetrySubmitter(options options.Options) *Submitter {
 		aggregators = append(aggregators, evaluationSummaryAggregator)
 	}
 
+	var loggerAggregator *LoggerAggregator
+	if options.CollectLoggerCounts {
+		loggerAggregator = NewLoggerAggregator()
+		aggregators = append(aggregators, loggerAggregator)
+	}
+
 	return &Submitter{
 		aggregators:                 aggregators,
 		host:                        options.TelemetryHost,
 		options:                     options,
+		httpClient:                  options.RequestHTTPClient(),
 		contextAggregators:          contextAggregators,
 		evaluationSummaryAggregator: evaluationSummaryAggregator,
+		loggerAggregator:            loggerAggregator,
 		mutex:                       &sync.Mutex{},
 		instanceHash:                options.InstanceHash,
 		queue:                       make(chan QueueItem, 10000),
+		done:                        make(chan struct{}),
+		closeOnce:                   &sync.Once{},
+		workers:                     &sync.WaitGroup{},
 	}
 }
 
 func (ts *Submitter) SetupQueueConsumer() {
+	ts.workers.Add(1)
+
 	go func() {
+		defer ts.workers.Done()
+
 		for {
-			for item := range ts.queue {
-				switch item := item.(type) {
-				case internal.ConfigMatch:
-					ts.internalRecordEvaluation(item)
-				case *contexts.ContextSet:
-					ts.internalRecordContext(item)
-				}
+			select {
+			case item := <-ts.queue:
+				ts.processQueueItem(item)
+			case <-ts.done:
+				return
 			}
 		}
 	}()
 }
 
+func (ts *Submitter) processQueueItem(item QueueItem) {
+	switch item := item.(type) {
+	case internal.ConfigMatch:
+		ts.internalRecordEvaluation(item)
+	case []internal.ConfigMatch:
+		for _, match := range item {
+			ts.internalRecordEvaluation(match)
+		}
+	case *contexts.ContextSet:
+		ts.internalRecordContext(item)
+	}
+}
+
 func (ts *Submitter) StartPeriodicSubmission(interval time.Duration) {
 	ts.SetupQueueConsumer()
 
 	ticker := time.NewTicker(interval)
 
+	ts.workers.Add(1)
+
 	go func() {
-		for range ticker.C {
-			if len(ts.aggregators) > 0 {
-				ts.Submit(false)
+		defer ts.workers.Done()
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if len(ts.aggregators) > 0 {
+					ts.Submit(false)
+				}
+			case <-ts.done:
+				return
 			}
 		}
 	}()
 }
 
+// Close stops the queue consumer and periodic submission, records anything
+// still sitting in the queue and makes a final submission. The final
+// submission (including retries) is abandoned when ctx is done. Calling Close
+// more than once is a no-op.
+func (ts *Submitter) Close(ctx context.Context) error {
+	alreadyClosed := true
+
+	ts.closeOnce.Do(func() {
+		alreadyClosed = false
+
+		close(ts.done)
+	})
+
+	if alreadyClosed {
+		return nil
+	}
+
+	ts.workers.Wait()
+	ts.drainQueue()
+
+	if len(ts.aggregators) == 0 {
+		return nil
+	}
+
+	return ts.submit(ctx, false)
+}
+
+func (ts *Submitter) drainQueue() {
+	for {
+		select {
+		case item := <-ts.queue:
+			ts.processQueueItem(item)
+		default:
+			return
+		}
+	}
+}
+
 func (ts *Submitter) enqueue(item QueueItem) {
+	select {
+	case <-ts.done:
+		// Submitter is closed, nothing will consume the queue
+		return
+	default:
+	}
+
 	select {
 	case ts.queue <- item:
 		// Successfully enqueued
@@ -115,10 +199,47 @@ func (ts *Submitter) RecordEvaluation(data internal.ConfigMatch) {
 	ts.enqueue(data)
 }
 
+// RecordEvaluations is RecordEvaluation for a batch, taking a single place in the queue.
+func (ts *Submitter) RecordEvaluations(data []internal.ConfigMatch) {
+	if ts.evaluationSummaryAggregator == nil {
+		return
+	}
+
+	recorded := make([]internal.ConfigMatch, 0, len(data))
+
+	for _, match := range data {
+		if !match.IsMatch {
+			continue
+		}
+
+		// We don't track log level evaluations
+		if _, ok := match.Match.GetType().(*prefabProto.ConfigValue_LogLevel); ok {
+			continue
+		}
+
+		recorded = append(recorded, match)
+	}
+
+	if len(recorded) > 0 {
+		ts.enqueue(recorded)
+	}
+}
+
 func (ts *Submitter) internalRecordEvaluation(data internal.ConfigMatch) {
 	ts.evaluationSummaryAggregator.Record(data)
 }
 
+// RecordLog counts a log record. Unlike evaluations and contexts it isn't
+// queued: counting is cheaper than a queue slot, and busy loggers would
+// otherwise crowd evaluations out of the queue.
+func (ts *Submitter) RecordLog(loggerName string, level prefabProto.LogLevel) {
+	if ts.loggerAggregator == nil {
+		return
+	}
+
+	ts.loggerAggregator.Record(LogRecord{LoggerName: loggerName, Level: level})
+}
+
 func (ts *Submitter) RecordContext(data *contexts.ContextSet) {
 	if ts.contextAggregators == nil {
 		return
@@ -134,6 +255,10 @@ func (ts *Submitter) internalRecordContext(data *contexts.ContextSet) {
 }
 
 func (ts *Submitter) Submit(waitOnQueueToDrain bool) error {
+	return ts.submit(context.Background(), waitOnQueueToDrain)
+}
+
+func (ts *Submitter) submit(ctx context.Context, waitOnQueueToDrain bool) error {
 	ts.mutex.Lock()
 	defer ts.mutex.Unlock()
 
@@ -174,11 +299,12 @@ func (ts *Submitter) Submit(waitOnQueueToDrain bool) error {
 		return fmt.Errorf("failed to marshal payload: %v", err)
 	}
 
-	req, err := http.NewRequest("POST", url, bytes.NewReader(payloadData))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payloadData))
 	if err != nil {
 		return fmt.Errorf("failed to create request: %v", err)
 	}
 
+	ts.options.SetHTTPHeaders(req.Header)
 	req.Header.Set("Content-Type", "application/x-protobuf")
 	req.Header.Set("Accept", "application/x-protobuf")
 	req.Header.Set("X-Reforge-SDK-Version", internal.ClientVersionHeader)
@@ -201,7 +327,7 @@ func (ts *Submitter) retryRequest(req *http.Request) error {
 	backoff := 1 * time.Second
 
 	for attempt := 1; attempt <= maxRetries; attempt++ {
-		resp, err := client.Do(req)
+		resp, err := ts.httpClient.Do(req)
 
 		if err == nil && resp.StatusCode == http.StatusOK {
 			defer resp.Body.Close()
@@ -220,7 +346,12 @@ func (ts *Submitter) retryRequest(req *http.Request) error {
 			return fmt.Errorf("telemetry submission failed with status %s after %d attempts", resp.Status, attempt)
 		}
 
-		time.Sleep(backoff)
+		select {
+		case <-time.After(backoff):
+		case <-req.Context().Done():
+			return fmt.Errorf("telemetry submission abandoned after %d attempts: %w", attempt, req.Context().Err())
+		}
+
 		backoff *= 2
 	}
 
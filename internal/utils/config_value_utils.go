@@ -50,6 +50,8 @@ func Create(value any) (*prefabProto.ConfigValue, bool) {
 		configValue.Type = &prefabProto.ConfigValue_Double{Double: val.Float()}
 	case *prefabProto.ConfigValue_LogLevel:
 		configValue.Type = valueType
+	case *prefabProto.IntRange:
+		configValue.Type = &prefabProto.ConfigValue_IntRange{IntRange: valueType}
 	case time.Duration:
 		configValue.Type = &prefabProto.ConfigValue_Duration{Duration: &prefabProto.IsoDuration{Definition: durationToISO8601(valueType)}}
 	case map[string]interface{}:
@@ -113,6 +115,8 @@ func ExtractValue(cv *prefabProto.ConfigValue) (any, bool, error) {
 		val, ok := handleProvided(v.Provided)
 
 		return val, ok, nil
+	case *prefabProto.ConfigValue_IntRange:
+		return v.IntRange, true, nil
 	default:
 		// For other types, return the protobuf value itself and false.
 		return v, false, nil
@@ -157,6 +161,10 @@ func GetValueType(cv *prefabProto.ConfigValue) prefabProto.Config_ValueType {
 		return prefabProto.Config_DURATION
 	case *prefabProto.ConfigValue_Json:
 		return prefabProto.Config_JSON
+	case *prefabProto.ConfigValue_IntRange:
+		return prefabProto.Config_INT_RANGE
+	case *prefabProto.ConfigValue_LimitDefinition:
+		return prefabProto.Config_LIMIT_DEFINITION
 	}
 	// For other types, return the protobuf value itself and false.
 	return prefabProto.Config_NOT_SET_VALUE_TYPE
@@ -182,6 +190,65 @@ func ExtractStringValue(cv *prefabProto.ConfigValue) (string, bool) {
 	}
 }
 
+func ExtractBytesValue(cv *prefabProto.ConfigValue) ([]byte, bool) {
+	switch v := cv.GetType().(type) {
+	case *prefabProto.ConfigValue_Bytes:
+		return v.Bytes, true
+	default:
+		return nil, false
+	}
+}
+
+func ExtractIntRangeValue(cv *prefabProto.ConfigValue) (*prefabProto.IntRange, bool) {
+	switch v := cv.GetType().(type) {
+	case *prefabProto.ConfigValue_IntRange:
+		return v.IntRange, v.IntRange != nil
+	default:
+		return nil, false
+	}
+}
+
+// ParseIntRange parses "start..end" into an IntRange. Start is inclusive and
+// end exclusive, as in the proto; either may be left out for an open bound,
+// so "..10", "5.." and ".." are all valid.
+func ParseIntRange(value string) (*prefabProto.IntRange, error) {
+	startText, endText, found := strings.Cut(strings.TrimSpace(value), "..")
+	if !found {
+		return nil, fmt.Errorf("int range %q must look like start..end", value)
+	}
+
+	intRange := &prefabProto.IntRange{}
+
+	if startText = strings.TrimSpace(startText); startText != "" {
+		start, err := strconv.ParseInt(startText, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("int range %q has an invalid start: %w", value, err)
+		}
+
+		intRange.Start = &start
+	}
+
+	if endText = strings.TrimSpace(endText); endText != "" {
+		end, err := strconv.ParseInt(endText, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("int range %q has an invalid end: %w", value, err)
+		}
+
+		intRange.End = &end
+	}
+
+	return intRange, nil
+}
+
+func ExtractLimitDefinitionValue(cv *prefabProto.ConfigValue) (*prefabProto.LimitDefinition, bool) {
+	switch v := cv.GetType().(type) {
+	case *prefabProto.ConfigValue_LimitDefinition:
+		return v.LimitDefinition, v.LimitDefinition != nil
+	default:
+		return nil, false
+	}
+}
+
 func ExtractJSONValueWithoutError(cv *prefabProto.ConfigValue) (interface{}, bool) {
 	jsonValue, ok, err := ExtractJSONValue(cv)
 	if err != nil {
@@ -191,6 +258,16 @@ func ExtractJSONValueWithoutError(cv *prefabProto.ConfigValue) (interface{}, boo
 	return jsonValue, ok
 }
 
+// ExtractJSONStringValue returns the raw, undecoded JSON document of a JSON value
+func ExtractJSONStringValue(cv *prefabProto.ConfigValue) (string, bool) {
+	switch v := cv.GetType().(type) {
+	case *prefabProto.ConfigValue_Json:
+		return v.Json.GetJson(), true
+	default:
+		return "", false
+	}
+}
+
 func ExtractJSONValue(cv *prefabProto.ConfigValue) (interface{}, bool, error) {
 	switch v := cv.GetType().(type) {
 	case *prefabProto.ConfigValue_Json:
@@ -35,3 +35,38 @@ func TestExtractValue(t *testing.T) {
 		assert.Equal(t, time.Minute, extracted)
 	})
 }
+
+func TestParseIntRange(t *testing.T) {
+	tests := []struct {
+		input string
+		start *int64
+		end   *int64
+	}{
+		{input: "10..20", start: int64Ptr(10), end: int64Ptr(20)},
+		{input: " -5 .. 5 ", start: int64Ptr(-5), end: int64Ptr(5)},
+		{input: "..100", end: int64Ptr(100)},
+		{input: "7..", start: int64Ptr(7)},
+		{input: ".."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			intRange, err := utils.ParseIntRange(tt.input)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.start, intRange.Start)
+			assert.Equal(t, tt.end, intRange.End)
+		})
+	}
+
+	for _, input := range []string{"", "10", "10-20", "a..b", "1..2..3"} {
+		t.Run("rejects "+input, func(t *testing.T) {
+			_, err := utils.ParseIntRange(input)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func int64Ptr(value int64) *int64 {
+	return &value
+}
@@ -0,0 +1,353 @@
+package reforge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ReforgeHQ/sdk-go/internal"
+	"github.com/ReforgeHQ/sdk-go/internal/utils"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+// limitPeriods is the period each policy's limit applies to. Policies missing
+// here, INFINITE and NOT_SET, don't limit.
+var limitPeriods = map[prefabProto.LimitResponse_LimitPolicyNames]time.Duration{
+	prefabProto.LimitResponse_SECONDLY_ROLLING: time.Second,
+	prefabProto.LimitResponse_MINUTELY_ROLLING: time.Minute,
+	prefabProto.LimitResponse_HOURLY_ROLLING:   time.Hour,
+	prefabProto.LimitResponse_DAILY_ROLLING:    24 * time.Hour,
+	prefabProto.LimitResponse_MONTHLY_ROLLING:  30 * 24 * time.Hour,
+	prefabProto.LimitResponse_YEARLY_ROLLING:   365 * 24 * time.Hour,
+}
+
+// limiterSweepSize is how many group buckets a Limiter holds before it drops
+// the ones that have refilled, which are no different from new ones.
+const limiterSweepSize = 1024
+
+// Limiter is a token bucket rate limiter configured by a LimitDefinition
+// config. The bucket holds up to the definition's burst (its limit when burst
+// is unset) and refills at limit tokens per policy period.
+//
+// Limits are enforced per process; they are not shared between instances.
+// The definition's SafetyLevel, which chooses how a shared limit behaves when
+// its backing store is unavailable, is therefore ignored.
+type Limiter struct {
+	bound       *ContextBoundClient
+	now         func() time.Time
+	definition  *prefabProto.LimitDefinition
+	buckets     map[string]*tokenBucket
+	changed     chan struct{}
+	unsubscribe func()
+	key         string
+	rate        float64 // tokens per second, +Inf when unlimited
+	burst       float64
+	sweepAt     int
+	mutex       sync.Mutex
+}
+
+type tokenBucket struct {
+	last   time.Time
+	tokens float64
+}
+
+// Limiter returns a Limiter for key evaluated against the global context. See ContextBoundClient.Limiter.
+func (c *Client) Limiter(key string) (*Limiter, error) {
+	return c.boundClient.Limiter(key)
+}
+
+// Limiter returns a Limiter configured from the LimitDefinition key evaluates
+// to for the bound context. When a change to key arrives, the limiter is
+// resized in place: buckets keep their tokens, capped at the new burst. If key
+// is later deleted or no longer holds a limit definition, the limiter keeps
+// its previous definition.
+//
+// ErrKeyNotFound or ErrTypeMismatch is returned if key doesn't currently
+// evaluate to a limit definition. Call Close when the limiter is no longer
+// needed.
+func (c *ContextBoundClient) Limiter(key string) (*Limiter, error) {
+	if c.client.closed.Load() {
+		return nil, ErrClientClosed
+	}
+
+	limiter := &Limiter{
+		bound:   c,
+		now:     time.Now,
+		buckets: make(map[string]*tokenBucket),
+		changed: make(chan struct{}),
+		key:     key,
+		sweepAt: limiterSweepSize,
+	}
+
+	// Subscribe before the first evaluation so a change landing in between isn't missed
+	limiter.unsubscribe = c.client.OnChange(key, func(ChangeEvent) {
+		limiter.reload()
+	})
+
+	if err := c.client.readyForEvaluation(context.Background()); err != nil {
+		limiter.unsubscribe()
+
+		return nil, err
+	}
+
+	// Evaluate under the lock so a concurrent reload can't be overwritten by an older definition
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	definition, err := limiter.evaluate()
+	if err != nil {
+		limiter.unsubscribe()
+
+		return nil, err
+	}
+
+	limiter.apply(definition)
+
+	return limiter, nil
+}
+
+// Allow reports whether one token is available and takes it. See AllowN.
+func (l *Limiter) Allow(groups ...string) bool {
+	return l.AllowN(1, groups...)
+}
+
+// AllowN reports whether n tokens are available and takes them. Without
+// groups, all callers share one bucket. With groups, as in
+// LimitRequest.Groups, each group has its own bucket and the tokens are taken
+// from all of them or none, so the most exhausted group decides.
+func (l *Limiter) AllowN(n int, groups ...string) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.take(float64(n), groups, l.now()) == 0
+}
+
+// Wait blocks until one token is available and takes it. See WaitN.
+func (l *Limiter) Wait(ctx context.Context, groups ...string) error {
+	return l.WaitN(ctx, 1, groups...)
+}
+
+// WaitN blocks until n tokens are available in every group's bucket and takes
+// them, returning ctx's error if it is done first. Waiters aren't queued: one
+// that wakes up competes with AllowN callers for the refilled tokens. A new
+// definition wakes waiters so they see the new rate. ErrExceedsBurst is
+// returned if n is more than the bucket can ever hold. A definition with a
+// zero limit and burst holds nothing, so waiters block until a new
+// definition arrives.
+func (l *Limiter) WaitN(ctx context.Context, n int, groups ...string) error {
+	for {
+		l.mutex.Lock()
+
+		if l.burst > 0 && float64(n) > l.burst {
+			l.mutex.Unlock()
+
+			return fmt.Errorf("%w: %d tokens requested from %q, burst is %v", ErrExceedsBurst, n, l.key, l.burst)
+		}
+
+		wait := l.take(float64(n), groups, l.now())
+		changed := l.changed
+
+		l.mutex.Unlock()
+
+		if wait == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(wait)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return ctx.Err()
+		case <-changed:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// Definition returns the limit definition currently in effect. Treat it as read-only.
+func (l *Limiter) Definition() *prefabProto.LimitDefinition {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.definition
+}
+
+// Close stops the limiter from receiving new definitions. It keeps limiting with the last one.
+func (l *Limiter) Close() {
+	l.unsubscribe()
+}
+
+func (l *Limiter) evaluate() (*prefabProto.LimitDefinition, error) {
+	match, err := l.bound.client.configResolver.ResolveValue(l.key, l.bound.context)
+	if errors.Is(err, internal.ErrConfigDoesNotExist) {
+		return nil, fmt.Errorf("%w: %q", ErrKeyNotFound, l.key)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !match.IsMatch || match.Match == nil {
+		return nil, fmt.Errorf("%w: no rule produced a value for %q", ErrKeyNotFound, l.key)
+	}
+
+	definition, ok := utils.ExtractLimitDefinitionValue(match.Match)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q holds a %s value", ErrTypeMismatch, l.key, utils.GetValueType(match.Match))
+	}
+
+	return definition, nil
+}
+
+func (l *Limiter) reload() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	// Before the initial evaluation there is no definition to keep
+	if l.definition == nil {
+		return
+	}
+
+	definition, err := l.evaluate()
+	if err != nil {
+		slog.Warn(fmt.Sprintf("limiter for %s keeps its previous definition: %v", l.key, err))
+
+		return
+	}
+
+	l.apply(definition)
+}
+
+// apply switches to definition and wakes waiters. The caller holds the mutex.
+func (l *Limiter) apply(definition *prefabProto.LimitDefinition) {
+	now := l.now()
+
+	// Tokens earned so far were earned at the old rate
+	for _, bucket := range l.buckets {
+		l.refill(bucket, now)
+	}
+
+	l.definition = definition
+
+	period, limited := limitPeriods[definition.GetPolicyName()]
+	if limited {
+		l.rate = float64(definition.GetLimit()) / period.Seconds()
+		l.burst = float64(definition.GetBurst())
+
+		if l.burst <= 0 {
+			l.burst = float64(definition.GetLimit())
+		}
+	} else {
+		l.rate = math.Inf(1)
+		l.burst = math.Inf(1)
+	}
+
+	for _, bucket := range l.buckets {
+		bucket.tokens = min(bucket.tokens, l.burst)
+	}
+
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
+
+// take takes n tokens from every group's bucket and returns 0, or, if any of
+// them is short, takes nothing and returns how long until they all could have
+// enough. The caller holds the mutex.
+func (l *Limiter) take(n float64, groups []string, now time.Time) time.Duration {
+	if math.IsInf(l.rate, 1) {
+		return 0
+	}
+
+	if len(groups) == 0 {
+		groups = []string{""}
+	}
+
+	// Sweep before collecting buckets so none of them is dropped mid-take
+	if len(l.buckets) >= l.sweepAt {
+		l.sweep(now)
+	}
+
+	buckets := make([]*tokenBucket, 0, len(groups))
+
+	var wait time.Duration
+
+	for _, group := range groups {
+		bucket := l.bucket(group, now)
+
+		// A group listed twice is still one bucket
+		if slices.Contains(buckets, bucket) {
+			continue
+		}
+
+		buckets = append(buckets, bucket)
+
+		if missing := n - bucket.tokens; missing > 0 {
+			wait = max(wait, l.timeToEarn(missing))
+		}
+	}
+
+	if wait > 0 {
+		return wait
+	}
+
+	for _, bucket := range buckets {
+		bucket.tokens -= n
+	}
+
+	return 0
+}
+
+// bucket returns group's bucket, refilled up to now. The caller holds the mutex.
+func (l *Limiter) bucket(group string, now time.Time) *tokenBucket {
+	bucket, exists := l.buckets[group]
+	if exists {
+		l.refill(bucket, now)
+
+		return bucket
+	}
+
+	bucket = &tokenBucket{last: now, tokens: l.burst}
+	l.buckets[group] = bucket
+
+	return bucket
+}
+
+func (l *Limiter) sweep(now time.Time) {
+	for group, bucket := range l.buckets {
+		l.refill(bucket, now)
+
+		if bucket.tokens >= l.burst {
+			delete(l.buckets, group)
+		}
+	}
+
+	l.sweepAt = max(limiterSweepSize, 2*len(l.buckets))
+}
+
+func (l *Limiter) refill(bucket *tokenBucket, now time.Time) {
+	elapsed := now.Sub(bucket.last)
+	if elapsed <= 0 {
+		return
+	}
+
+	bucket.tokens = min(l.burst, bucket.tokens+elapsed.Seconds()*l.rate)
+	bucket.last = now
+}
+
+func (l *Limiter) timeToEarn(tokens float64) time.Duration {
+	seconds := tokens / l.rate
+	if seconds >= math.MaxInt64/float64(time.Second) {
+		// A limit of zero never refills; wait for a new definition
+		return math.MaxInt64
+	}
+
+	return max(time.Nanosecond, time.Duration(math.Ceil(seconds*float64(time.Second))))
+}
@@ -0,0 +1,208 @@
+package reforge
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ReforgeHQ/sdk-go/internal/options"
+	"github.com/ReforgeHQ/sdk-go/internal/stores"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+func limitConfig(t *testing.T, key string, policy prefabProto.LimitResponse_LimitPolicyNames, limit int32, burst int32) *prefabProto.Config {
+	t.Helper()
+
+	return staticConfig(t, key, &prefabProto.ConfigValue{Type: &prefabProto.ConfigValue_LimitDefinition{
+		LimitDefinition: &prefabProto.LimitDefinition{PolicyName: policy, Limit: limit, Burst: burst},
+	}})
+}
+
+func TestLimiter(t *testing.T) {
+	store := &mutableTestStore{configs: map[string]*prefabProto.Config{}}
+	store.set(limitConfig(t, "api.limit", prefabProto.LimitResponse_MINUTELY_ROLLING, 60, 2))
+	store.set(staticConfig(t, "not.a.limit", int64(5)))
+
+	client, err := NewSdk(WithCustomStore(store), WithOfflineSources([]string{}), WithAllTelemetryDisabled())
+	require.NoError(t, err)
+
+	limiter, err := client.Limiter("api.limit")
+	require.NoError(t, err)
+
+	defer limiter.Close()
+
+	now := time.Now()
+	limiter.now = func() time.Time { return now }
+
+	t.Run("holds burst tokens and refills at the limit's rate", func(t *testing.T) {
+		assert.True(t, limiter.Allow())
+		assert.True(t, limiter.Allow())
+		assert.False(t, limiter.Allow())
+
+		now = now.Add(time.Second)
+		assert.True(t, limiter.Allow())
+		assert.False(t, limiter.Allow())
+	})
+
+	t.Run("groups have their own buckets", func(t *testing.T) {
+		assert.True(t, limiter.AllowN(2, "user:1"))
+		assert.False(t, limiter.Allow("user:1"))
+		assert.True(t, limiter.Allow("user:2"))
+
+		// The exhausted group denies the request, and user:2 keeps its token
+		assert.False(t, limiter.Allow("user:1", "user:2"))
+		assert.True(t, limiter.Allow("user:2"))
+	})
+
+	t.Run("resizes when a new definition arrives", func(t *testing.T) {
+		client.changeListeners.dispatch([]stores.ConfigChange{
+			store.set(limitConfig(t, "api.limit", prefabProto.LimitResponse_SECONDLY_ROLLING, 10, 5)),
+		})
+
+		assert.Equal(t, int32(10), limiter.Definition().GetLimit())
+
+		now = now.Add(time.Second)
+		assert.True(t, limiter.AllowN(5, "user:3"))
+		assert.False(t, limiter.Allow("user:3"))
+	})
+
+	t.Run("keeps its definition when the key stops holding one", func(t *testing.T) {
+		client.changeListeners.dispatch([]stores.ConfigChange{store.set(staticConfig(t, "api.limit", "oops"))})
+
+		assert.Equal(t, int32(10), limiter.Definition().GetLimit())
+	})
+
+	t.Run("drops refilled group buckets", func(t *testing.T) {
+		limiter.mutex.Lock()
+		limiter.buckets = make(map[string]*tokenBucket)
+		limiter.mutex.Unlock()
+
+		for i := range limiterSweepSize {
+			limiter.Allow(fmt.Sprintf("visitor:%d", i))
+		}
+
+		now = now.Add(time.Second)
+		assert.True(t, limiter.Allow("visitor:new"))
+
+		limiter.mutex.Lock()
+		defer limiter.mutex.Unlock()
+
+		assert.Len(t, limiter.buckets, 1)
+	})
+
+	t.Run("infinite policy doesn't limit", func(t *testing.T) {
+		client.changeListeners.dispatch([]stores.ConfigChange{
+			store.set(limitConfig(t, "api.limit", prefabProto.LimitResponse_INFINITE, 0, 0)),
+		})
+
+		for range 100 {
+			require.True(t, limiter.Allow("user:3"))
+		}
+	})
+
+	t.Run("rejects keys without a limit definition", func(t *testing.T) {
+		_, err := client.Limiter("not.a.limit")
+		require.ErrorIs(t, err, ErrTypeMismatch)
+
+		_, err = client.Limiter("missing")
+		require.ErrorIs(t, err, ErrKeyNotFound)
+	})
+}
+
+func TestLimiterWait(t *testing.T) {
+	store := &mutableTestStore{configs: map[string]*prefabProto.Config{}}
+	store.set(limitConfig(t, "api.limit", prefabProto.LimitResponse_SECONDLY_ROLLING, 50, 1))
+
+	client, err := NewSdk(WithCustomStore(store), WithOfflineSources([]string{}), WithAllTelemetryDisabled())
+	require.NoError(t, err)
+
+	limiter, err := client.Limiter("api.limit")
+	require.NoError(t, err)
+
+	defer limiter.Close()
+
+	require.NoError(t, limiter.Wait(context.Background()))
+
+	start := time.Now()
+	require.NoError(t, limiter.Wait(context.Background()))
+	assert.GreaterOrEqual(t, time.Since(start), 15*time.Millisecond, "a token takes 20ms to refill")
+
+	require.ErrorIs(t, limiter.WaitN(context.Background(), 2), ErrExceedsBurst)
+
+	t.Run("gives up when the context is done", func(t *testing.T) {
+		client.changeListeners.dispatch([]stores.ConfigChange{
+			store.set(limitConfig(t, "api.limit", prefabProto.LimitResponse_DAILY_ROLLING, 1, 1)),
+		})
+
+		require.True(t, limiter.Allow("daily"))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		require.ErrorIs(t, limiter.Wait(ctx, "daily"), context.DeadlineExceeded)
+	})
+
+	t.Run("wakes waiters when the definition changes", func(t *testing.T) {
+		waited := make(chan error, 1)
+
+		go func() { waited <- limiter.Wait(context.Background(), "daily") }()
+
+		time.Sleep(10 * time.Millisecond)
+		client.changeListeners.dispatch([]stores.ConfigChange{
+			store.set(limitConfig(t, "api.limit", prefabProto.LimitResponse_INFINITE, 0, 0)),
+		})
+
+		select {
+		case err := <-waited:
+			require.NoError(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("waiter was not woken by the new definition")
+		}
+	})
+
+	t.Run("a zero limit blocks waiters until a new definition arrives", func(t *testing.T) {
+		client.changeListeners.dispatch([]stores.ConfigChange{
+			store.set(limitConfig(t, "api.limit", prefabProto.LimitResponse_MINUTELY_ROLLING, 0, 0)),
+		})
+
+		assert.False(t, limiter.Allow())
+
+		waited := make(chan error, 1)
+
+		go func() { waited <- limiter.Wait(context.Background()) }()
+
+		select {
+		case err := <-waited:
+			t.Fatalf("waiter returned %v under a zero limit", err)
+		case <-time.After(20 * time.Millisecond):
+		}
+
+		client.changeListeners.dispatch([]stores.ConfigChange{
+			store.set(limitConfig(t, "api.limit", prefabProto.LimitResponse_SECONDLY_ROLLING, 50, 1)),
+		})
+
+		select {
+		case err := <-waited:
+			require.NoError(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("waiter was not woken by the new definition")
+		}
+	})
+}
+
+func TestLimiterHonorsOnInitializationFailure(t *testing.T) {
+	client := newNeverInitClient(t, 0.05, options.ReturnError)
+
+	_, err := client.Limiter("test.key")
+	require.ErrorIs(t, err, ErrInitTimeout)
+
+	// Carrying on evaluates the key, which holds a string
+	client = newNeverInitClient(t, 0.05, options.ReturnNilMatch)
+
+	_, err = client.Limiter("test.key")
+	require.ErrorIs(t, err, ErrTypeMismatch)
+}
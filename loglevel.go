@@ -61,3 +61,23 @@ func protoLogLevelToLogLevel(protoLevel prefabProto.LogLevel) LogLevel {
 		return Debug // Default to Debug for unknown/unset levels
 	}
 }
+
+// logLevelToProtoLogLevel converts our SDK LogLevel to a proto LogLevel
+func logLevelToProtoLogLevel(level LogLevel) (prefabProto.LogLevel, bool) {
+	switch level {
+	case Trace:
+		return prefabProto.LogLevel_TRACE, true
+	case Debug:
+		return prefabProto.LogLevel_DEBUG, true
+	case Info:
+		return prefabProto.LogLevel_INFO, true
+	case Warn:
+		return prefabProto.LogLevel_WARN, true
+	case Error:
+		return prefabProto.LogLevel_ERROR, true
+	case Fatal:
+		return prefabProto.LogLevel_FATAL, true
+	default:
+		return prefabProto.LogLevel_NOT_SET_LOG_LEVEL, false
+	}
+}
@@ -1,6 +1,8 @@
 package reforge
 
 import (
+	"errors"
+	"net/http"
 	"time"
 
 	"github.com/ReforgeHQ/sdk-go/internal/options"
@@ -69,6 +71,169 @@ func WithSources(sources []string, excludeDefault bool) Option {
 	}
 }
 
+// WithPollingInterval makes the API source fetch updates every interval
+// instead of holding an SSE connection open, for networks whose proxies cut
+// long-lived connections. Each poll asks only for changes since the last one.
+// Polls are jittered by ±10%, and consecutive failures back off exponentially
+// up to five minutes.
+//
+// When listing sources explicitly, a "poll://30s" source does the same; "poll://" alone uses
+// this option's interval, or 30 seconds.
+func WithPollingInterval(interval time.Duration) Option {
+	return func(o *options.Options) error {
+		if interval <= 0 {
+			return errors.New("polling interval must be positive")
+		}
+
+		o.PollingInterval = interval
+
+		return nil
+	}
+}
+
+// WithHTTPClient makes config fetches, the SSE stream and telemetry use
+// client, e.g. one configured with a proxy or client certificates. The client
+// is copied, so later changes to it have no effect. Its Timeout applies to
+// config fetches and telemetry (unless WithHTTPTimeout is set) but not to the
+// long-lived SSE stream.
+func WithHTTPClient(client *http.Client) Option {
+	return func(o *options.Options) error {
+		if client == nil {
+			return errors.New("http client must not be nil")
+		}
+
+		o.HTTPClient = client
+
+		return nil
+	}
+}
+
+// WithTransport sets the http.RoundTripper used for config fetches, the SSE
+// stream and telemetry. It replaces the Transport of the client given to
+// WithHTTPClient, if any.
+//
+// Example:
+//
+//	transport := http.DefaultTransport.(*http.Transport).Clone()
+//	transport.Proxy = http.ProxyURL(proxyURL)
+//	transport.TLSClientConfig = &tls.Config{Certificates: []tls.Certificate{cert}}
+//
+//	client, err := reforge.NewSdk(reforge.WithTransport(transport))
+func WithTransport(transport http.RoundTripper) Option {
+	return func(o *options.Options) error {
+		if transport == nil {
+			return errors.New("transport must not be nil")
+		}
+
+		o.HTTPTransport = transport
+
+		return nil
+	}
+}
+
+// WithHTTPTimeout bounds each config fetch and telemetry submission. The SSE
+// stream is not bounded, since it stays open. Defaults to the Timeout of the
+// client given to WithHTTPClient, or 10 seconds.
+func WithHTTPTimeout(timeout time.Duration) Option {
+	return func(o *options.Options) error {
+		if timeout <= 0 {
+			return errors.New("http timeout must be positive")
+		}
+
+		o.HTTPTimeout = timeout
+
+		return nil
+	}
+}
+
+// WithHTTPHeaders adds headers to every request the SDK makes, e.g. for a
+// proxy that requires its own authorization. They cannot override the SDK's
+// Authorization, version or content headers.
+func WithHTTPHeaders(headers map[string]string) Option {
+	return func(o *options.Options) error {
+		o.HTTPHeaders = make(map[string]string, len(headers))
+
+		for name, value := range headers {
+			o.HTTPHeaders[name] = value
+		}
+
+		return nil
+	}
+}
+
+// WithCacheDir keeps a last known good copy of the configs in dir. It is
+// rewritten atomically after every successful load or streamed update, with a
+// checksum and the high watermark. On startup a valid copy is served right
+// away, so the client initializes even when the API is unreachable, and the
+// first fetch only asks for changes since it was written.
+//
+// The directory is created if needed. Clients with different SDK keys can share it.
+func WithCacheDir(dir string) Option {
+	return func(o *options.Options) error {
+		if dir == "" {
+			return errors.New("cache dir must not be empty")
+		}
+
+		o.CacheDir = dir
+
+		return nil
+	}
+}
+
+// WithDatafileWatch turns on checking datafile sources for changes every 2
+// seconds, or at the interval set with WithDatafileReloadInterval. A changed
+// file is re-parsed and swapped in whole, and change listeners are notified;
+// a file that fails to parse leaves the previous configs in place.
+//
+// Watching is off by default, so datafiles are loaded once and never checked
+// again. Datafiles are mostly used as fixed snapshots in tests and offline
+// runs, where a config changing mid-run would be a surprise, and watching
+// costs every client a goroutine and a stat of each file per interval. Turn
+// it on where the file is updated in place, such as a mounted ConfigMap.
+func WithDatafileWatch(enabled bool) Option {
+	return func(o *options.Options) error {
+		switch {
+		case !enabled:
+			o.DatafileReloadInterval = 0
+		case o.DatafileReloadInterval <= 0:
+			o.DatafileReloadInterval = options.DefaultDatafileReloadInterval
+		}
+
+		return nil
+	}
+}
+
+// WithDatafileReloadInterval turns on watching datafile sources, as
+// WithDatafileWatch does, and sets how often they are checked for changes.
+func WithDatafileReloadInterval(interval time.Duration) Option {
+	return func(o *options.Options) error {
+		if interval <= 0 {
+			return errors.New("datafile reload interval must be positive")
+		}
+
+		o.DatafileReloadInterval = interval
+
+		return nil
+	}
+}
+
+// WithEnvironmentNames layers environment overlays over each datafile
+// source, in order, with keys in later files winning. For
+// "datafile://config.yaml" and []string{"production", "production.eu"} the
+// SDK loads config.yaml, then config.production.yaml, then
+// config.production.eu.yaml, skipping overlays that don't exist. File names
+// containing ".default." have it replaced instead, so
+// ".prefab.default.config.yaml" is overlaid by ".prefab.production.config.yaml".
+//
+// Defaults to the comma-separated names in the REFORGE_ENVIRONMENTS environment variable.
+func WithEnvironmentNames(environmentNames []string) Option {
+	return func(o *options.Options) error {
+		o.EnvironmentNames = environmentNames
+
+		return nil
+	}
+}
+
 // WithGlobalContext sets the global context for the prefab client.
 func WithGlobalContext(globalContext *ContextSet) Option {
 	return func(o *options.Options) error {
@@ -89,7 +254,11 @@ func WithSdkKey(sdkKey string) Option {
 
 // WithAPIURLs sets the API URLs for the prefab client.
 //
-// You likely will never need to use this option.
+// You likely will never need to use this option. It is, however, the only
+// way to get SSE stream failover: the stream moves on to the stream host of
+// the next URL when one fails, and both default URLs map to the single
+// stream host stream.reforge.com, so with the defaults there is no failover,
+// only reconnects with backoff.
 func WithAPIURLs(apiURL []string) Option {
 	return func(o *options.Options) error {
 		o.APIURLs = apiURL
@@ -186,10 +355,40 @@ func WithCollectEvaluationSummaries(collect bool) Option {
 	}
 }
 
+// WithCollectLoggerCounts sets whether the client should count log records per
+// logger name and level. The counts let the Reforge UI list your loggers for
+// per-logger level targeting. Records are counted by the logging
+// integrations, or by calling RecordLog.
+//
+// The default is false
+func WithCollectLoggerCounts(collect bool) Option {
+	return func(o *options.Options) error {
+		o.CollectLoggerCounts = collect
+
+		return nil
+	}
+}
+
+// WithStrictEvaluation makes evaluation results unambiguous:
+//
+//   - the Get*WithDefault methods and FeatureIsOn return wasFound=false when they fall back to the default
+//   - the other getters return errors wrapping ErrKeyNotFound, ErrTypeMismatch, ErrInitTimeout or
+//     ErrDecryption instead of ok=false or untyped errors
+//
+// The default is false, which keeps the original behavior where wasFound is always true.
+func WithStrictEvaluation(strict bool) Option {
+	return func(o *options.Options) error {
+		o.StrictEvaluation = strict
+
+		return nil
+	}
+}
+
 func WithAllTelemetryDisabled() Option {
 	return func(o *options.Options) error {
 		o.ContextTelemetryMode = options.ContextTelemetryModes.None
 		o.CollectEvaluationSummaries = false
+		o.CollectLoggerCounts = false
 		return nil
 	}
 }
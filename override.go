@@ -0,0 +1,178 @@
+package reforge
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ReforgeHQ/sdk-go/internal"
+	"github.com/ReforgeHQ/sdk-go/internal/stores"
+	"github.com/ReforgeHQ/sdk-go/internal/utils"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+// OverrideOption configures an override set with Client.Override.
+type OverrideOption func(*overrideSettings)
+
+type overrideSettings struct {
+	when func(ContextValueGetter) bool
+	ttl  time.Duration
+}
+
+// OverrideTTL removes the override after ttl.
+func OverrideTTL(ttl time.Duration) OverrideOption {
+	return func(s *overrideSettings) {
+		s.ttl = ttl
+	}
+}
+
+// OverrideWhen limits the override to evaluations whose context satisfies
+// predicate. Other evaluations see the value from the usual sources. The
+// predicate is called on every evaluation of the key, so it should be fast.
+func OverrideWhen(predicate func(ContextValueGetter) bool) OverrideOption {
+	return func(s *overrideSettings) {
+		s.when = predicate
+	}
+}
+
+// Override makes key evaluate to value until the returned undo func is
+// called, whatever the API or datafiles say, so it survives streamed updates.
+// value is either a plain value (bool, string, int64, float64, []string,
+// time.Duration, ...) or a *prefabProto.Config whose rows target specific
+// contexts. Overrides stack: the most recent one that applies wins, and
+// undoing it reveals the one before. Change listeners are notified whenever an
+// override of key is set, undone or expires, scoped or not, so watchers and
+// bindings re-evaluate.
+//
+// Example:
+//
+//	undo, err := client.Override("checkout.enabled", false)
+//	defer undo()
+func (c *Client) Override(key string, value any, opts ...OverrideOption) (func(), error) {
+	settings := overrideSettings{}
+
+	for _, opt := range opts {
+		opt(&settings)
+	}
+
+	if settings.ttl < 0 {
+		return nil, errors.New("override ttl must not be negative")
+	}
+
+	config, err := overrideConfig(key, value)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		expiresAt time.Time
+		when      func(internal.ContextValueGetter) bool
+	)
+
+	if settings.ttl > 0 {
+		expiresAt = time.Now().Add(settings.ttl)
+	}
+
+	if settings.when != nil {
+		when = func(contextSet internal.ContextValueGetter) bool {
+			return settings.when(contextSet)
+		}
+	}
+
+	var remove func()
+
+	c.notifyOverrideChange(key, config, func() {
+		remove = c.overrides.Set(config, expiresAt, when)
+	})
+
+	var (
+		undoOnce sync.Once
+		timer    *time.Timer
+	)
+
+	removeOnce := func() {
+		undoOnce.Do(func() {
+			c.notifyOverrideChange(key, nil, remove)
+		})
+	}
+
+	if settings.ttl > 0 {
+		timer = time.AfterFunc(settings.ttl, removeOnce)
+	}
+
+	return func() {
+		if timer != nil {
+			timer.Stop()
+		}
+
+		removeOnce()
+	}, nil
+}
+
+// notifyOverrideChange runs update, which adds or removes one of key's
+// overrides, and dispatches a change for it. Old and New are the configs that
+// apply to every context; when no config does, New is the added override, or
+// a tombstone after a removal.
+func (c *Client) notifyOverrideChange(key string, added *prefabProto.Config, update func()) {
+	before, _ := c.configStore.GetConfig(key)
+
+	update()
+
+	after, exists := c.configStore.GetConfig(key)
+
+	switch {
+	case exists:
+	case added != nil:
+		after = added
+	default:
+		after = &prefabProto.Config{Key: key}
+	}
+
+	c.changeListeners.dispatch([]stores.ConfigChange{{Key: key, Old: before, New: after}})
+}
+
+func overrideConfig(key string, value any) (*prefabProto.Config, error) {
+	if config, ok := value.(*prefabProto.Config); ok {
+		if config == nil || len(config.GetRows()) == 0 {
+			return nil, fmt.Errorf("override of %q must have at least one row", key)
+		}
+
+		config = proto.Clone(config).(*prefabProto.Config)
+		config.Key = key
+
+		return config, nil
+	}
+
+	if level, ok := value.(LogLevel); ok {
+		protoLevel, known := logLevelToProtoLogLevel(level)
+		if !known {
+			return nil, fmt.Errorf("can't override %q with unknown log level %d", key, level)
+		}
+
+		value = &prefabProto.ConfigValue_LogLevel{LogLevel: protoLevel}
+	}
+
+	if intRange, ok := value.(IntRange); ok {
+		value = intRangeToProto(intRange)
+	}
+
+	configValue, ok := utils.Create(value)
+	if !ok || configValue.GetType() == nil {
+		return nil, fmt.Errorf("%w: can't override %q with a %T", ErrTypeMismatch, key, value)
+	}
+
+	configType := prefabProto.ConfigType_CONFIG
+	if _, isLogLevel := configValue.GetType().(*prefabProto.ConfigValue_LogLevel); isLogLevel {
+		configType = prefabProto.ConfigType_LOG_LEVEL
+	}
+
+	return &prefabProto.Config{
+		Key:        key,
+		Rows:       []*prefabProto.ConfigRow{{Values: []*prefabProto.ConditionalValue{{Value: configValue}}}},
+		ValueType:  utils.GetValueType(configValue),
+		ConfigType: configType,
+	}, nil
+}
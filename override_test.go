@@ -0,0 +1,144 @@
+package reforge
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ReforgeHQ/sdk-go/internal/stores"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+func TestOverride(t *testing.T) {
+	store := &mutableTestStore{configs: map[string]*prefabProto.Config{}}
+	store.set(staticConfig(t, "checkout.enabled", true))
+
+	client, err := NewSdk(WithCustomStore(store), WithOfflineSources([]string{}), WithAllTelemetryDisabled())
+	require.NoError(t, err)
+
+	var changes []ChangeEvent
+
+	client.OnChange("checkout.", func(event ChangeEvent) { changes = append(changes, event) })
+
+	enabled := func(contextSet ContextSet) bool {
+		value, ok, err := client.GetBoolValue("checkout.enabled", contextSet)
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		return value
+	}
+
+	undo, err := client.Override("checkout.enabled", false)
+	require.NoError(t, err)
+	assert.False(t, enabled(*NewContextSet()))
+
+	// A streamed update doesn't replace the override
+	client.changeListeners.dispatch([]stores.ConfigChange{store.set(staticConfig(t, "checkout.enabled", true))})
+	assert.False(t, enabled(*NewContextSet()))
+
+	// Overrides stack and undo in any order
+	undoInner, err := client.Override("checkout.enabled", true)
+	require.NoError(t, err)
+	assert.True(t, enabled(*NewContextSet()))
+
+	undo()
+	undo()
+	assert.True(t, enabled(*NewContextSet()))
+
+	undoInner()
+	assert.True(t, enabled(*NewContextSet()))
+	assert.Len(t, changes, 5, "set, streamed update, stacked set and both undos")
+
+	// Scoped overrides only apply to matching contexts, but still notify listeners
+	undoScoped, err := client.Override("checkout.enabled", false, OverrideWhen(func(contextSet ContextValueGetter) bool {
+		plan, _ := contextSet.GetContextValue("user.plan")
+
+		return plan == "free"
+	}))
+	require.NoError(t, err)
+	require.Len(t, changes, 6)
+	assert.Same(t, changes[5].OldConfig, changes[5].NewConfig, "the config for every context is unchanged")
+
+	free := *NewContextSet().WithNamedContextValues("user", map[string]interface{}{"plan": "free"})
+	paid := *NewContextSet().WithNamedContextValues("user", map[string]interface{}{"plan": "pro"})
+
+	assert.False(t, enabled(free))
+	assert.True(t, enabled(paid))
+
+	undoScoped()
+	assert.Len(t, changes, 7)
+	assert.True(t, enabled(free))
+}
+
+func TestOverrideExpiresAfterTTL(t *testing.T) {
+	client, err := NewSdk(WithOfflineSources([]string{}), WithAllTelemetryDisabled())
+	require.NoError(t, err)
+
+	_, err = client.Override("temporary", "on", OverrideTTL(20*time.Millisecond))
+	require.NoError(t, err)
+
+	value, ok, err := client.GetStringValue("temporary", *NewContextSet())
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "on", value)
+
+	require.Eventually(t, func() bool {
+		_, ok, _ := client.GetStringValue("temporary", *NewContextSet())
+
+		return !ok
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestOverrideWithTargetedConfig(t *testing.T) {
+	client, err := NewSdk(WithOfflineSources([]string{}), WithAllTelemetryDisabled())
+	require.NoError(t, err)
+
+	undo, err := client.Override("tier", tieredConfig(t, 1, 10, 1))
+	require.NoError(t, err)
+
+	defer undo()
+
+	gold := *NewContextSet().WithNamedContextValues("user", map[string]interface{}{"tier": "gold"})
+
+	value, ok, err := client.GetIntValue("tier", gold)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int64(10), value)
+
+	_, err = client.Override("level", LogLevel(99))
+	require.Error(t, err)
+
+	_, err = client.Override("bad", struct{}{})
+	require.ErrorIs(t, err, ErrTypeMismatch)
+}
+
+func TestScopedOverrideNotifiesWatchers(t *testing.T) {
+	store := &mutableTestStore{configs: map[string]*prefabProto.Config{}}
+	store.set(tieredConfig(t, 1, 500, 100))
+
+	client, err := NewSdk(WithCustomStore(store), WithOfflineSources([]string{}), WithAllTelemetryDisabled())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	silver := NewContextSet().WithNamedContextValues("user", map[string]interface{}{"tier": "silver"})
+
+	updates, err := client.WithContext(silver).Watch(ctx, "kafka.batch.size")
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), receiveUpdate(t, updates).Value)
+
+	undo, err := client.Override("kafka.batch.size", int64(1), OverrideWhen(func(contextSet ContextValueGetter) bool {
+		tier, _ := contextSet.GetContextValue("user.tier")
+
+		return tier == "silver"
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), receiveUpdate(t, updates).Value)
+
+	undo()
+	assert.Equal(t, int64(100), receiveUpdate(t, updates).Value)
+}
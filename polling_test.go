@@ -0,0 +1,68 @@
+package reforge_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	reforge "github.com/ReforgeHQ/sdk-go"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+func TestWithPollingIntervalAppliesUpdates(t *testing.T) {
+	var version atomic.Int64
+
+	version.Store(1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		id := version.Load()
+
+		body, err := proto.Marshal(&prefabProto.Configs{Configs: []*prefabProto.Config{{
+			Key: "greeting",
+			Id:  id,
+			Rows: []*prefabProto.ConfigRow{{Values: []*prefabProto.ConditionalValue{{
+				Value: &prefabProto.ConfigValue{Type: &prefabProto.ConfigValue_String_{String_: map[int64]string{1: "hello", 2: "hi"}[id]}},
+			}}}},
+		}}})
+		assert.NoError(t, err)
+
+		_, err = w.Write(body)
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	client, err := reforge.NewSdk(
+		reforge.WithSdkKey("test-key"),
+		reforge.WithAPIURLs([]string{server.URL}),
+		reforge.WithPollingInterval(10*time.Millisecond),
+		reforge.WithAllTelemetryDisabled(),
+	)
+	require.NoError(t, err)
+
+	defer client.Close(context.Background())
+
+	greeting, ok, err := client.GetStringValue("greeting", *reforge.NewContextSet())
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "hello", greeting)
+
+	version.Store(2)
+
+	require.Eventually(t, func() bool {
+		greeting, _, _ := client.GetStringValue("greeting", *reforge.NewContextSet())
+
+		return greeting == "hi"
+	}, 2*time.Second, 5*time.Millisecond)
+}
+
+func TestWithPollingIntervalRejectsNonPositiveIntervals(t *testing.T) {
+	_, err := reforge.NewSdk(reforge.WithPollingInterval(0))
+	require.Error(t, err)
+}
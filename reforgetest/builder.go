@@ -0,0 +1,296 @@
+package reforgetest
+
+import (
+	"fmt"
+
+	"github.com/ReforgeHQ/sdk-go/internal/utils"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+// ConfigBuilder builds a *prefabProto.Config from rules, evaluated in the
+// order they were added. The first rule whose criteria all match wins.
+//
+//	config := reforgetest.Config("checkout.timeout").
+//		When(reforgetest.Prop("user.plan").IsOneOf("pro")).Then(30).
+//		When(reforgetest.InSegment("beta-testers")).Rollout("user.key", reforgetest.Weight(1, 20), reforgetest.Weight(1, 10)).
+//		Otherwise(10).
+//		Build()
+//
+// Values are anything the SDK can store: bool, string, int64 and the other
+// integer types, float64, []string, []byte, time.Duration, map[string]any as
+// JSON, or a *prefabProto.ConfigValue.
+type ConfigBuilder struct {
+	key        string
+	values     []*prefabProto.ConditionalValue
+	configType prefabProto.ConfigType
+}
+
+// RuleBuilder is a rule waiting for its value, returned by ConfigBuilder.When.
+type RuleBuilder struct {
+	builder  *ConfigBuilder
+	criteria []*prefabProto.Criterion
+}
+
+// WeightedValue is one slice of a rollout, created with Weight.
+type WeightedValue struct {
+	value  any
+	weight int32
+}
+
+// Config starts building a config with the given key.
+func Config(key string) *ConfigBuilder {
+	return &ConfigBuilder{key: key, configType: prefabProto.ConfigType_CONFIG}
+}
+
+// FeatureFlag starts building a feature flag with the given key.
+func FeatureFlag(key string) *ConfigBuilder {
+	return &ConfigBuilder{key: key, configType: prefabProto.ConfigType_FEATURE_FLAG}
+}
+
+// Segment starts building a segment with the given key. Each rule added with
+// Includes matches contexts in the segment; every other context is outside it.
+func Segment(key string) *ConfigBuilder {
+	return &ConfigBuilder{key: key, configType: prefabProto.ConfigType_SEGMENT}
+}
+
+// When starts a rule that applies when every criterion matches.
+func (b *ConfigBuilder) When(criteria ...*prefabProto.Criterion) *RuleBuilder {
+	return &RuleBuilder{builder: b, criteria: criteria}
+}
+
+// Then completes the rule with a value.
+func (r *RuleBuilder) Then(value any) *ConfigBuilder {
+	return r.builder.add(r.criteria, configValue(r.builder.key, value))
+}
+
+// Rollout completes the rule with a value picked by hashing the context
+// property hashBy, split by weight.
+func (r *RuleBuilder) Rollout(hashBy string, values ...WeightedValue) *ConfigBuilder {
+	return r.builder.add(r.criteria, rolloutValue(r.builder.key, hashBy, values))
+}
+
+// Rollout adds a rule that applies to every context, picking a value by
+// hashing the context property hashBy, split by weight.
+func (b *ConfigBuilder) Rollout(hashBy string, values ...WeightedValue) *ConfigBuilder {
+	return b.add(nil, rolloutValue(b.key, hashBy, values))
+}
+
+// Otherwise adds a rule that applies to every context.
+func (b *ConfigBuilder) Otherwise(value any) *ConfigBuilder {
+	return b.add(nil, configValue(b.key, value))
+}
+
+// Includes adds a segment rule matching contexts for which every criterion matches.
+func (b *ConfigBuilder) Includes(criteria ...*prefabProto.Criterion) *ConfigBuilder {
+	return b.add(criteria, configValue(b.key, true))
+}
+
+// Build returns the config. It panics if no rules were added to a config or
+// feature flag.
+func (b *ConfigBuilder) Build() *prefabProto.Config {
+	values := append([]*prefabProto.ConditionalValue(nil), b.values...)
+
+	if b.configType == prefabProto.ConfigType_SEGMENT {
+		values = append(values, &prefabProto.ConditionalValue{Value: configValue(b.key, false)})
+	}
+
+	if len(values) == 0 {
+		panic(fmt.Sprintf("reforgetest: %s has no rules", b.key))
+	}
+
+	return &prefabProto.Config{
+		Key:        b.key,
+		Rows:       []*prefabProto.ConfigRow{{Values: values}},
+		ValueType:  valueType(values),
+		ConfigType: b.configType,
+	}
+}
+
+func (b *ConfigBuilder) add(criteria []*prefabProto.Criterion, value *prefabProto.ConfigValue) *ConfigBuilder {
+	b.values = append(b.values, &prefabProto.ConditionalValue{Criteria: criteria, Value: value})
+
+	return b
+}
+
+// Weight is a rollout slice: value is picked for weight out of the total
+// weight of the rollout.
+func Weight(weight int32, value any) WeightedValue {
+	return WeightedValue{weight: weight, value: value}
+}
+
+func configValue(key string, value any) *prefabProto.ConfigValue {
+	created, ok := utils.Create(value)
+	if !ok || created.GetType() == nil {
+		panic(fmt.Sprintf("reforgetest: %s: unsupported value %v (%T)", key, value, value))
+	}
+
+	return created
+}
+
+func rolloutValue(key string, hashBy string, values []WeightedValue) *prefabProto.ConfigValue {
+	if len(values) == 0 {
+		panic(fmt.Sprintf("reforgetest: %s: rollout needs at least one weighted value", key))
+	}
+
+	weightedValues := &prefabProto.WeightedValues{HashByPropertyName: &hashBy}
+
+	for _, value := range values {
+		weightedValues.WeightedValues = append(weightedValues.WeightedValues, &prefabProto.WeightedValue{Weight: value.weight, Value: configValue(key, value.value)})
+	}
+
+	return &prefabProto.ConfigValue{Type: &prefabProto.ConfigValue_WeightedValues{WeightedValues: weightedValues}}
+}
+
+func valueType(values []*prefabProto.ConditionalValue) prefabProto.Config_ValueType {
+	for _, conditionalValue := range values {
+		value := conditionalValue.GetValue()
+		if weighted := value.GetWeightedValues(); weighted != nil && len(weighted.GetWeightedValues()) > 0 {
+			value = weighted.GetWeightedValues()[0].GetValue()
+		}
+
+		if valueType := utils.GetValueType(value); valueType != prefabProto.Config_NOT_SET_VALUE_TYPE {
+			return valueType
+		}
+	}
+
+	return prefabProto.Config_NOT_SET_VALUE_TYPE
+}
+
+// PropertyCriteria creates criteria on a context property, e.g. "user.email".
+type PropertyCriteria struct {
+	name string
+}
+
+// Prop starts a criterion on the context property name, e.g. "user.email".
+func Prop(name string) PropertyCriteria {
+	return PropertyCriteria{name: name}
+}
+
+// IsOneOf matches when the property equals one of values.
+func (p PropertyCriteria) IsOneOf(values ...string) *prefabProto.Criterion {
+	return p.value(prefabProto.Criterion_PROP_IS_ONE_OF, values)
+}
+
+// IsNotOneOf matches when the property equals none of values.
+func (p PropertyCriteria) IsNotOneOf(values ...string) *prefabProto.Criterion {
+	return p.value(prefabProto.Criterion_PROP_IS_NOT_ONE_OF, values)
+}
+
+// StartsWith matches when the property starts with one of prefixes.
+func (p PropertyCriteria) StartsWith(prefixes ...string) *prefabProto.Criterion {
+	return p.value(prefabProto.Criterion_PROP_STARTS_WITH_ONE_OF, prefixes)
+}
+
+// DoesNotStartWith matches when the property starts with none of prefixes.
+func (p PropertyCriteria) DoesNotStartWith(prefixes ...string) *prefabProto.Criterion {
+	return p.value(prefabProto.Criterion_PROP_DOES_NOT_START_WITH_ONE_OF, prefixes)
+}
+
+// EndsWith matches when the property ends with one of suffixes.
+func (p PropertyCriteria) EndsWith(suffixes ...string) *prefabProto.Criterion {
+	return p.value(prefabProto.Criterion_PROP_ENDS_WITH_ONE_OF, suffixes)
+}
+
+// DoesNotEndWith matches when the property ends with none of suffixes.
+func (p PropertyCriteria) DoesNotEndWith(suffixes ...string) *prefabProto.Criterion {
+	return p.value(prefabProto.Criterion_PROP_DOES_NOT_END_WITH_ONE_OF, suffixes)
+}
+
+// Contains matches when the property contains one of substrings.
+func (p PropertyCriteria) Contains(substrings ...string) *prefabProto.Criterion {
+	return p.value(prefabProto.Criterion_PROP_CONTAINS_ONE_OF, substrings)
+}
+
+// DoesNotContain matches when the property contains none of substrings.
+func (p PropertyCriteria) DoesNotContain(substrings ...string) *prefabProto.Criterion {
+	return p.value(prefabProto.Criterion_PROP_DOES_NOT_CONTAIN_ONE_OF, substrings)
+}
+
+// LessThan matches when the property is a number less than value.
+func (p PropertyCriteria) LessThan(value any) *prefabProto.Criterion {
+	return p.value(prefabProto.Criterion_PROP_LESS_THAN, value)
+}
+
+// LessThanOrEqual matches when the property is a number less than or equal to value.
+func (p PropertyCriteria) LessThanOrEqual(value any) *prefabProto.Criterion {
+	return p.value(prefabProto.Criterion_PROP_LESS_THAN_OR_EQUAL, value)
+}
+
+// GreaterThan matches when the property is a number greater than value.
+func (p PropertyCriteria) GreaterThan(value any) *prefabProto.Criterion {
+	return p.value(prefabProto.Criterion_PROP_GREATER_THAN, value)
+}
+
+// GreaterThanOrEqual matches when the property is a number greater than or equal to value.
+func (p PropertyCriteria) GreaterThanOrEqual(value any) *prefabProto.Criterion {
+	return p.value(prefabProto.Criterion_PROP_GREATER_THAN_OR_EQUAL, value)
+}
+
+// Before matches when the property is a time before value, given as an RFC
+// 3339 string or Unix milliseconds.
+func (p PropertyCriteria) Before(value any) *prefabProto.Criterion {
+	return p.value(prefabProto.Criterion_PROP_BEFORE, value)
+}
+
+// After matches when the property is a time after value, given as an RFC
+// 3339 string or Unix milliseconds.
+func (p PropertyCriteria) After(value any) *prefabProto.Criterion {
+	return p.value(prefabProto.Criterion_PROP_AFTER, value)
+}
+
+// Matches matches when the property matches the regular expression pattern.
+func (p PropertyCriteria) Matches(pattern string) *prefabProto.Criterion {
+	return p.value(prefabProto.Criterion_PROP_MATCHES, pattern)
+}
+
+// DoesNotMatch matches when the property doesn't match the regular expression pattern.
+func (p PropertyCriteria) DoesNotMatch(pattern string) *prefabProto.Criterion {
+	return p.value(prefabProto.Criterion_PROP_DOES_NOT_MATCH, pattern)
+}
+
+// SemverLessThan matches when the property is a semantic version lower than version.
+func (p PropertyCriteria) SemverLessThan(version string) *prefabProto.Criterion {
+	return p.value(prefabProto.Criterion_PROP_SEMVER_LESS_THAN, version)
+}
+
+// SemverEqual matches when the property is the semantic version version.
+func (p PropertyCriteria) SemverEqual(version string) *prefabProto.Criterion {
+	return p.value(prefabProto.Criterion_PROP_SEMVER_EQUAL, version)
+}
+
+// SemverGreaterThan matches when the property is a semantic version higher than version.
+func (p PropertyCriteria) SemverGreaterThan(version string) *prefabProto.Criterion {
+	return p.value(prefabProto.Criterion_PROP_SEMVER_GREATER_THAN, version)
+}
+
+// InIntRange matches when the property is a number in [start, end).
+func (p PropertyCriteria) InIntRange(start int64, end int64) *prefabProto.Criterion {
+	return &prefabProto.Criterion{
+		PropertyName: p.name,
+		Operator:     prefabProto.Criterion_IN_INT_RANGE,
+		ValueToMatch: &prefabProto.ConfigValue{Type: &prefabProto.ConfigValue_IntRange{IntRange: &prefabProto.IntRange{Start: &start, End: &end}}},
+	}
+}
+
+func (p PropertyCriteria) value(operator prefabProto.Criterion_CriterionOperator, value any) *prefabProto.Criterion {
+	return &prefabProto.Criterion{PropertyName: p.name, Operator: operator, ValueToMatch: configValue(p.name, value)}
+}
+
+// InSegment matches contexts in the segment with the given key.
+func InSegment(key string) *prefabProto.Criterion {
+	return segmentCriterion(prefabProto.Criterion_IN_SEG, key)
+}
+
+// NotInSegment matches contexts outside the segment with the given key.
+func NotInSegment(key string) *prefabProto.Criterion {
+	return segmentCriterion(prefabProto.Criterion_NOT_IN_SEG, key)
+}
+
+// Always matches every context.
+func Always() *prefabProto.Criterion {
+	return &prefabProto.Criterion{Operator: prefabProto.Criterion_ALWAYS_TRUE}
+}
+
+func segmentCriterion(operator prefabProto.Criterion_CriterionOperator, key string) *prefabProto.Criterion {
+	return &prefabProto.Criterion{Operator: operator, ValueToMatch: configValue(key, key)}
+}
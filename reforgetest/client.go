@@ -0,0 +1,399 @@
+// Package reforgetest helps test code that uses the Reforge SDK. It provides
+// an in-memory Client that evaluates configs exactly like a real one and
+// records every evaluation, builders for targeted configs, and Server, a fake
+// Reforge API for end-to-end tests of streaming and reconnects.
+//
+//	func TestCheckout(t *testing.T) {
+//		client := reforgetest.NewClient(t)
+//		client.SetConfig(reforgetest.FeatureFlag("checkout.enabled").
+//			When(reforgetest.Prop("user.plan").IsOneOf("pro")).Then(true).
+//			Otherwise(false).
+//			Build())
+//
+//		runCheckout(client, proUser)
+//
+//		client.AssertEvaluatedWith(t, "checkout.enabled", proUserContext)
+//	}
+package reforgetest
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	reforge "github.com/ReforgeHQ/sdk-go"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+// Evaluation is a single evaluation recorded by Client.
+type Evaluation struct {
+	Value   any
+	Err     error
+	Context reforge.ContextSet
+	Key     string
+	Found   bool
+}
+
+// Client is a reforge.ClientInterface backed only by memory. Values set with
+// Set and SetConfig are evaluated by the real SDK, so rules, rollouts and
+// segments behave as they would in production. Evaluations made through the
+// ClientInterface getters are recorded for the Assert helpers; evaluations
+// through WithContext or the generic getters are not.
+//
+// The embedded *reforge.Client is available for the rest of the SDK's API,
+// such as OnChange and Override.
+type Client struct {
+	*reforge.Client
+	undo        map[string]func()
+	evaluations []Evaluation
+	mutex       sync.Mutex
+}
+
+var _ reforge.ClientInterface = (*Client)(nil)
+
+// NewClient returns a Client with no configs and telemetry disabled. opts are
+// applied after those defaults, e.g. reforge.WithGlobalContext. The client is
+// closed when the test finishes.
+func NewClient(t testing.TB, opts ...reforge.Option) *Client {
+	t.Helper()
+
+	opts = append([]reforge.Option{reforge.WithOfflineSources([]string{}), reforge.WithAllTelemetryDisabled()}, opts...)
+
+	client, err := reforge.NewSdk(opts...)
+	if err != nil {
+		t.Fatalf("reforgetest: creating client: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_ = client.Close(context.Background())
+	})
+
+	return &Client{Client: client, undo: make(map[string]func())}
+}
+
+// Set makes key evaluate to value, replacing any value set before. value is a
+// plain value (bool, string, int64, float64, []string, time.Duration, []byte,
+// reforge.IntRange, reforge.LogLevel, ...) or a *prefabProto.Config. Change listeners are notified.
+func (c *Client) Set(key string, value any) error {
+	undo, err := c.Client.Override(key, value)
+	if err != nil {
+		return fmt.Errorf("reforgetest: setting %q: %w", key, err)
+	}
+
+	c.mutex.Lock()
+	previous := c.undo[key]
+	c.undo[key] = undo
+	c.mutex.Unlock()
+
+	if previous != nil {
+		previous()
+	}
+
+	return nil
+}
+
+// SetConfig sets each config under its own key, as built by ConfigBuilder. It
+// panics if a config can't be set, which only happens for configs without rows.
+func (c *Client) SetConfig(configs ...*prefabProto.Config) {
+	for _, config := range configs {
+		if err := c.Set(config.GetKey(), config); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// Delete removes the value set for key.
+func (c *Client) Delete(key string) {
+	c.mutex.Lock()
+	undo := c.undo[key]
+	delete(c.undo, key)
+	c.mutex.Unlock()
+
+	if undo != nil {
+		undo()
+	}
+}
+
+// Evaluations returns the evaluations recorded so far, oldest first.
+func (c *Client) Evaluations() []Evaluation {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return append([]Evaluation(nil), c.evaluations...)
+}
+
+// ResetEvaluations forgets the evaluations recorded so far.
+func (c *Client) ResetEvaluations() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.evaluations = nil
+}
+
+// AssertEvaluated reports a test error unless key was evaluated.
+func (c *Client) AssertEvaluated(t testing.TB, key string) bool {
+	t.Helper()
+
+	for _, evaluation := range c.Evaluations() {
+		if evaluation.Key == key {
+			return true
+		}
+	}
+
+	t.Errorf("reforgetest: expected %q to be evaluated, but it wasn't", key)
+
+	return false
+}
+
+// AssertNotEvaluated reports a test error if key was evaluated.
+func (c *Client) AssertNotEvaluated(t testing.TB, key string) bool {
+	t.Helper()
+
+	for _, evaluation := range c.Evaluations() {
+		if evaluation.Key == key {
+			t.Errorf("reforgetest: expected %q not to be evaluated, but it was with context %v", key, evaluation.Context.Data)
+
+			return false
+		}
+	}
+
+	return true
+}
+
+// AssertEvaluatedWith reports a test error unless key was evaluated with a
+// context containing every value in contextSet. Other values in the
+// evaluated context are ignored.
+func (c *Client) AssertEvaluatedWith(t testing.TB, key string, contextSet reforge.ContextSet) bool {
+	t.Helper()
+
+	var seen []map[string]*reforge.NamedContext
+
+	for _, evaluation := range c.Evaluations() {
+		if evaluation.Key != key {
+			continue
+		}
+
+		if contextContains(evaluation.Context, contextSet) {
+			return true
+		}
+
+		seen = append(seen, evaluation.Context.Data)
+	}
+
+	if len(seen) == 0 {
+		t.Errorf("reforgetest: expected %q to be evaluated, but it wasn't", key)
+	} else {
+		t.Errorf("reforgetest: expected %q to be evaluated with a context containing %v, but it was evaluated with %v", key, contextData(contextSet), contextDataList(seen))
+	}
+
+	return false
+}
+
+func contextContains(actual reforge.ContextSet, expected reforge.ContextSet) bool {
+	for name, namedContext := range expected.Data {
+		for property, expectedValue := range namedContext.Data {
+			actualValue, exists := actual.GetContextValue(name + "." + property)
+			if !exists || !reflect.DeepEqual(actualValue, expectedValue) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func contextData(contextSet reforge.ContextSet) map[string]map[string]any {
+	data := make(map[string]map[string]any, len(contextSet.Data))
+	for name, namedContext := range contextSet.Data {
+		data[name] = namedContext.Data
+	}
+
+	return data
+}
+
+func contextDataList(contexts []map[string]*reforge.NamedContext) []map[string]map[string]any {
+	data := make([]map[string]map[string]any, len(contexts))
+	for index, namedContexts := range contexts {
+		data[index] = contextData(reforge.ContextSet{Data: namedContexts})
+	}
+
+	return data
+}
+
+func (c *Client) record(key string, contextSet reforge.ContextSet, value any, found bool, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.evaluations = append(c.evaluations, Evaluation{Key: key, Context: contextSet, Value: value, Found: found, Err: err})
+}
+
+// GetIntValue evaluates key like reforge.Client.GetIntValue and records the evaluation
+func (c *Client) GetIntValue(key string, contextSet reforge.ContextSet) (int64, bool, error) {
+	value, ok, err := c.Client.GetIntValue(key, contextSet)
+	c.record(key, contextSet, value, ok, err)
+
+	return value, ok, err
+}
+
+// GetBoolValue evaluates key like reforge.Client.GetBoolValue and records the evaluation
+func (c *Client) GetBoolValue(key string, contextSet reforge.ContextSet) (bool, bool, error) {
+	value, ok, err := c.Client.GetBoolValue(key, contextSet)
+	c.record(key, contextSet, value, ok, err)
+
+	return value, ok, err
+}
+
+// GetStringValue evaluates key like reforge.Client.GetStringValue and records the evaluation
+func (c *Client) GetStringValue(key string, contextSet reforge.ContextSet) (string, bool, error) {
+	value, ok, err := c.Client.GetStringValue(key, contextSet)
+	c.record(key, contextSet, value, ok, err)
+
+	return value, ok, err
+}
+
+// GetFloatValue evaluates key like reforge.Client.GetFloatValue and records the evaluation
+func (c *Client) GetFloatValue(key string, contextSet reforge.ContextSet) (float64, bool, error) {
+	value, ok, err := c.Client.GetFloatValue(key, contextSet)
+	c.record(key, contextSet, value, ok, err)
+
+	return value, ok, err
+}
+
+// GetStringSliceValue evaluates key like reforge.Client.GetStringSliceValue and records the evaluation
+func (c *Client) GetStringSliceValue(key string, contextSet reforge.ContextSet) ([]string, bool, error) {
+	value, ok, err := c.Client.GetStringSliceValue(key, contextSet)
+	c.record(key, contextSet, value, ok, err)
+
+	return value, ok, err
+}
+
+// GetDurationValue evaluates key like reforge.Client.GetDurationValue and records the evaluation
+func (c *Client) GetDurationValue(key string, contextSet reforge.ContextSet) (time.Duration, bool, error) {
+	value, ok, err := c.Client.GetDurationValue(key, contextSet)
+	c.record(key, contextSet, value, ok, err)
+
+	return value, ok, err
+}
+
+// GetBytesValue evaluates key like reforge.Client.GetBytesValue and records the evaluation
+func (c *Client) GetBytesValue(key string, contextSet reforge.ContextSet) ([]byte, bool, error) {
+	value, ok, err := c.Client.GetBytesValue(key, contextSet)
+	c.record(key, contextSet, value, ok, err)
+
+	return value, ok, err
+}
+
+// GetIntRangeValue evaluates key like reforge.Client.GetIntRangeValue and records the evaluation
+func (c *Client) GetIntRangeValue(key string, contextSet reforge.ContextSet) (reforge.IntRange, bool, error) {
+	value, ok, err := c.Client.GetIntRangeValue(key, contextSet)
+	c.record(key, contextSet, value, ok, err)
+
+	return value, ok, err
+}
+
+// GetJSONValue evaluates key like reforge.Client.GetJSONValue and records the evaluation
+func (c *Client) GetJSONValue(key string, contextSet reforge.ContextSet) (interface{}, bool, error) {
+	value, ok, err := c.Client.GetJSONValue(key, contextSet)
+	c.record(key, contextSet, value, ok, err)
+
+	return value, ok, err
+}
+
+// GetLogLevelStringValue evaluates key like reforge.Client.GetLogLevelStringValue and records the evaluation
+func (c *Client) GetLogLevelStringValue(key string, contextSet reforge.ContextSet) (string, bool, error) {
+	value, ok, err := c.Client.GetLogLevelStringValue(key, contextSet)
+	c.record(key, contextSet, value, ok, err)
+
+	return value, ok, err
+}
+
+// GetIntValueWithDefault evaluates key like reforge.Client.GetIntValueWithDefault and records the evaluation
+func (c *Client) GetIntValueWithDefault(key string, contextSet reforge.ContextSet, defaultValue int64) (int64, bool) {
+	value, ok := c.Client.GetIntValueWithDefault(key, contextSet, defaultValue)
+	c.record(key, contextSet, value, ok, nil)
+
+	return value, ok
+}
+
+// GetBoolValueWithDefault evaluates key like reforge.Client.GetBoolValueWithDefault and records the evaluation
+func (c *Client) GetBoolValueWithDefault(key string, contextSet reforge.ContextSet, defaultValue bool) (bool, bool) {
+	value, ok := c.Client.GetBoolValueWithDefault(key, contextSet, defaultValue)
+	c.record(key, contextSet, value, ok, nil)
+
+	return value, ok
+}
+
+// GetStringValueWithDefault evaluates key like reforge.Client.GetStringValueWithDefault and records the evaluation
+func (c *Client) GetStringValueWithDefault(key string, contextSet reforge.ContextSet, defaultValue string) (string, bool) {
+	value, ok := c.Client.GetStringValueWithDefault(key, contextSet, defaultValue)
+	c.record(key, contextSet, value, ok, nil)
+
+	return value, ok
+}
+
+// GetFloatValueWithDefault evaluates key like reforge.Client.GetFloatValueWithDefault and records the evaluation
+func (c *Client) GetFloatValueWithDefault(key string, contextSet reforge.ContextSet, defaultValue float64) (float64, bool) {
+	value, ok := c.Client.GetFloatValueWithDefault(key, contextSet, defaultValue)
+	c.record(key, contextSet, value, ok, nil)
+
+	return value, ok
+}
+
+// GetStringSliceValueWithDefault evaluates key like reforge.Client.GetStringSliceValueWithDefault and records the evaluation
+func (c *Client) GetStringSliceValueWithDefault(key string, contextSet reforge.ContextSet, defaultValue []string) ([]string, bool) {
+	value, ok := c.Client.GetStringSliceValueWithDefault(key, contextSet, defaultValue)
+	c.record(key, contextSet, value, ok, nil)
+
+	return value, ok
+}
+
+// GetDurationWithDefault evaluates key like reforge.Client.GetDurationWithDefault and records the evaluation
+func (c *Client) GetDurationWithDefault(key string, contextSet reforge.ContextSet, defaultValue time.Duration) (time.Duration, bool) {
+	value, ok := c.Client.GetDurationWithDefault(key, contextSet, defaultValue)
+	c.record(key, contextSet, value, ok, nil)
+
+	return value, ok
+}
+
+// GetBytesValueWithDefault evaluates key like reforge.Client.GetBytesValueWithDefault and records the evaluation
+func (c *Client) GetBytesValueWithDefault(key string, contextSet reforge.ContextSet, defaultValue []byte) ([]byte, bool) {
+	value, ok := c.Client.GetBytesValueWithDefault(key, contextSet, defaultValue)
+	c.record(key, contextSet, value, ok, nil)
+
+	return value, ok
+}
+
+// GetIntRangeValueWithDefault evaluates key like reforge.Client.GetIntRangeValueWithDefault and records the evaluation
+func (c *Client) GetIntRangeValueWithDefault(key string, contextSet reforge.ContextSet, defaultValue reforge.IntRange) (reforge.IntRange, bool) {
+	value, ok := c.Client.GetIntRangeValueWithDefault(key, contextSet, defaultValue)
+	c.record(key, contextSet, value, ok, nil)
+
+	return value, ok
+}
+
+// GetJSONValueWithDefault evaluates key like reforge.Client.GetJSONValueWithDefault and records the evaluation
+func (c *Client) GetJSONValueWithDefault(key string, contextSet reforge.ContextSet, defaultValue interface{}) (interface{}, bool) {
+	value, ok := c.Client.GetJSONValueWithDefault(key, contextSet, defaultValue)
+	c.record(key, contextSet, value, ok, nil)
+
+	return value, ok
+}
+
+// GetConfigMatch evaluates key like reforge.Client.GetConfigMatch and records the evaluation
+func (c *Client) GetConfigMatch(key string, contextSet reforge.ContextSet) (*reforge.ConfigMatch, error) {
+	match, err := c.Client.GetConfigMatch(key, contextSet)
+	c.record(key, contextSet, match, err == nil && match != nil && match.IsMatch, err)
+
+	return match, err
+}
+
+// FeatureIsOn evaluates key like reforge.Client.FeatureIsOn and records the evaluation
+func (c *Client) FeatureIsOn(key string, contextSet reforge.ContextSet) (bool, bool) {
+	value, ok := c.Client.FeatureIsOn(key, contextSet)
+	c.record(key, contextSet, value, ok, nil)
+
+	return value, ok
+}
@@ -0,0 +1,147 @@
+package reforgetest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	reforge "github.com/ReforgeHQ/sdk-go"
+	"github.com/ReforgeHQ/sdk-go/reforgetest"
+)
+
+func userContext(values map[string]interface{}) reforge.ContextSet {
+	return *reforge.NewContextSet().WithNamedContextValues("user", values)
+}
+
+// failureRecorder records assertion failures instead of failing the test.
+type failureRecorder struct {
+	testing.TB
+	failures int
+}
+
+func (r *failureRecorder) Helper() {}
+
+func (r *failureRecorder) Errorf(string, ...any) {
+	r.failures++
+}
+
+func TestClientSetReplacesAndDeletesValues(t *testing.T) {
+	client := reforgetest.NewClient(t)
+
+	require.NoError(t, client.Set("timeout", 5*time.Second))
+	require.NoError(t, client.Set("timeout", 10*time.Second))
+
+	value, ok, err := client.GetDurationValue("timeout", *reforge.NewContextSet())
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 10*time.Second, value)
+
+	client.Delete("timeout")
+
+	_, ok, _ = client.GetDurationValue("timeout", *reforge.NewContextSet())
+	assert.False(t, ok)
+
+	require.Error(t, client.Set("timeout", struct{}{}))
+}
+
+func TestClientEvaluatesBuiltRules(t *testing.T) {
+	client := reforgetest.NewClient(t)
+
+	client.SetConfig(
+		reforgetest.Segment("staff").
+			Includes(reforgetest.Prop("user.email").EndsWith("@example.com")).
+			Build(),
+		reforgetest.FeatureFlag("checkout.enabled").
+			When(reforgetest.InSegment("staff")).Then(true).
+			When(reforgetest.Prop("user.plan").IsOneOf("pro"), reforgetest.Prop("user.age").GreaterThanOrEqual(18)).Then(true).
+			Otherwise(false).
+			Build(),
+		reforgetest.Config("checkout.retries").
+			When(reforgetest.Prop("user.id").InIntRange(0, 100)).Then(5).
+			Otherwise(1).
+			Build(),
+	)
+
+	tests := []struct {
+		name    string
+		context map[string]interface{}
+		enabled bool
+		retries int64
+	}{
+		{name: "staff", context: map[string]interface{}{"email": "ada@example.com", "id": 500}, enabled: true, retries: 1},
+		{name: "adult pro", context: map[string]interface{}{"plan": "pro", "age": 30, "id": 7}, enabled: true, retries: 5},
+		{name: "minor pro", context: map[string]interface{}{"plan": "pro", "age": 12, "id": 7}, enabled: false, retries: 5},
+		{name: "free", context: map[string]interface{}{"plan": "free", "id": 100}, enabled: false, retries: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enabled, ok := client.FeatureIsOn("checkout.enabled", userContext(tt.context))
+			assert.True(t, ok)
+			assert.Equal(t, tt.enabled, enabled)
+
+			retries, ok, err := client.GetIntValue("checkout.retries", userContext(tt.context))
+			require.NoError(t, err)
+			assert.True(t, ok)
+			assert.Equal(t, tt.retries, retries)
+		})
+	}
+}
+
+func TestRolloutSplitsByWeight(t *testing.T) {
+	client := reforgetest.NewClient(t)
+
+	client.SetConfig(reforgetest.Config("variant").
+		Rollout("user.key", reforgetest.Weight(1, "a"), reforgetest.Weight(1, "b")).
+		Build())
+
+	seen := map[string]int{}
+
+	for i := 0; i < 200; i++ {
+		value, ok, err := client.GetStringValue("variant", userContext(map[string]interface{}{"key": i}))
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		seen[value]++
+	}
+
+	assert.Len(t, seen, 2)
+	assert.InDelta(t, 100, seen["a"], 30)
+}
+
+func TestClientRecordsEvaluations(t *testing.T) {
+	client := reforgetest.NewClient(t)
+	require.NoError(t, client.Set("checkout.enabled", true))
+
+	client.FeatureIsOn("checkout.enabled", userContext(map[string]interface{}{"key": "u1", "plan": "pro"}))
+	client.GetStringValueWithDefault("missing", *reforge.NewContextSet(), "fallback")
+
+	evaluations := client.Evaluations()
+	require.Len(t, evaluations, 2)
+	assert.Equal(t, "checkout.enabled", evaluations[0].Key)
+	assert.Equal(t, true, evaluations[0].Value)
+	assert.True(t, evaluations[0].Found)
+	assert.Equal(t, "missing", evaluations[1].Key)
+	assert.Equal(t, "fallback", evaluations[1].Value)
+
+	assert.True(t, client.AssertEvaluated(t, "checkout.enabled"))
+	assert.True(t, client.AssertEvaluatedWith(t, "checkout.enabled", userContext(map[string]interface{}{"plan": "pro"})))
+	assert.True(t, client.AssertNotEvaluated(t, "other"))
+
+	recorder := &failureRecorder{TB: t}
+	assert.False(t, client.AssertEvaluatedWith(recorder, "checkout.enabled", userContext(map[string]interface{}{"plan": "free"})))
+	assert.False(t, client.AssertNotEvaluated(recorder, "checkout.enabled"))
+	assert.False(t, client.AssertEvaluated(recorder, "other"))
+	assert.Equal(t, 3, recorder.failures)
+
+	client.ResetEvaluations()
+	assert.Empty(t, client.Evaluations())
+}
+
+func TestBuildPanicsWithoutRules(t *testing.T) {
+	assert.Panics(t, func() { reforgetest.Config("empty").Build() })
+	assert.Panics(t, func() { reforgetest.Config("bad").Otherwise(struct{}{}) })
+	assert.NotPanics(t, func() { reforgetest.Segment("nobody").Build() })
+}
@@ -0,0 +1,396 @@
+package reforgetest
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	reforge "github.com/ReforgeHQ/sdk-go"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+// ServerSdkKey is the SDK key Server.Options configures. The server accepts
+// any key, but rejects requests without one.
+const ServerSdkKey = "reforgetest-sdk-key"
+
+// Server is a fake Reforge API for end-to-end tests. It serves configs from
+// /api/v2/configs/{offset}, streams updates from /api/v2/sse/config and
+// records telemetry posted to /api/v1/telemetry.
+//
+//	server := reforgetest.NewServer(t, reforgetest.Config("retries").Otherwise(3).Build())
+//	client, err := reforge.NewSdk(server.Options()...)
+//	...
+//	server.Push(reforgetest.Config("retries").Otherwise(5).Build())
+//
+// Each config is given an increasing id when it is added, so clients that
+// resume from their high watermark only receive later configs, as with the
+// real API.
+type Server struct {
+	server        *httptest.Server
+	configs       map[string]*prefabProto.Config
+	streams       map[int]*stream
+	telemetry     []*prefabProto.TelemetryEvents
+	failStatus    int
+	failCount     int
+	latency       time.Duration
+	nextID        int64
+	nextStream    int
+	streamsOpened int
+	projectEnvID  int64
+	mutex         sync.Mutex
+}
+
+type stream struct {
+	events chan []byte
+	drop   chan struct{}
+}
+
+// NewServer starts a Server serving configs. It is closed when the test finishes.
+func NewServer(t testing.TB, configs ...*prefabProto.Config) *Server {
+	t.Helper()
+
+	s := &Server{
+		configs:      make(map[string]*prefabProto.Config),
+		streams:      make(map[int]*stream),
+		projectEnvID: 1,
+	}
+
+	s.mutex.Lock()
+	s.add(configs)
+	s.mutex.Unlock()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/configs/", s.handleConfigs)
+	mux.HandleFunc("/api/v2/sse/config", s.handleStream)
+	mux.HandleFunc("/api/v1/telemetry", s.handleTelemetry)
+
+	s.server = httptest.NewServer(s.middleware(mux))
+
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// URL is the server's base URL, for reforge.WithAPIURLs and reforge.WithTelemetryHost.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Options returns the options that point a client at the server.
+func (s *Server) Options() []reforge.Option {
+	return []reforge.Option{
+		reforge.WithSdkKey(ServerSdkKey),
+		reforge.WithAPIURLs([]string{s.URL()}),
+		reforge.WithTelemetryHost(s.URL()),
+	}
+}
+
+// Close drops every stream and shuts the server down.
+func (s *Server) Close() {
+	s.DropStreams()
+	s.server.CloseClientConnections()
+	s.server.Close()
+}
+
+// Push adds or replaces configs and sends them to every open stream.
+func (s *Server) Push(configs ...*prefabProto.Config) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.broadcast(s.add(configs))
+}
+
+// Delete removes the configs with the given keys and sends their deletion to
+// every open stream.
+func (s *Server) Delete(keys ...string) {
+	tombstones := make([]*prefabProto.Config, len(keys))
+	for index, key := range keys {
+		tombstones[index] = &prefabProto.Config{Key: key}
+	}
+
+	s.Push(tombstones...)
+}
+
+// SetProjectEnvID sets the project environment id sent with every response.
+func (s *Server) SetProjectEnvID(projectEnvID int64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.projectEnvID = projectEnvID
+}
+
+// DropStreams closes every open stream, as a load balancer restart would.
+// Clients reconnect on their own.
+func (s *Server) DropStreams() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for id, open := range s.streams {
+		close(open.drop)
+		delete(s.streams, id)
+	}
+}
+
+// FailRequests answers the next count requests with status, which should be
+// a 5xx to simulate an outage. A count of zero fails every request until
+// FailRequests is called with a status of zero.
+func (s *Server) FailRequests(status int, count int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.failStatus = status
+	s.failCount = count
+}
+
+// SetLatency delays every response by latency.
+func (s *Server) SetLatency(latency time.Duration) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.latency = latency
+}
+
+// OpenStreams returns the number of streams currently open.
+func (s *Server) OpenStreams() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return len(s.streams)
+}
+
+// StreamsOpened returns the number of streams opened since the server started,
+// including reconnects.
+func (s *Server) StreamsOpened() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.streamsOpened
+}
+
+// TelemetryEvents returns the telemetry received so far, oldest first.
+func (s *Server) TelemetryEvents() []*prefabProto.TelemetryEvents {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return append([]*prefabProto.TelemetryEvents(nil), s.telemetry...)
+}
+
+// add stores configs with fresh ids and returns the stored copies. The caller holds the mutex.
+func (s *Server) add(configs []*prefabProto.Config) []*prefabProto.Config {
+	added := make([]*prefabProto.Config, 0, len(configs))
+
+	for _, config := range configs {
+		s.nextID++
+
+		stored := proto.Clone(config).(*prefabProto.Config)
+		stored.Id = s.nextID
+		s.configs[stored.GetKey()] = stored
+
+		added = append(added, stored)
+	}
+
+	return added
+}
+
+// since returns the configs added after offset. A client starting from
+// scratch doesn't need deletions, so they are left out at offset zero. The
+// caller holds the mutex.
+func (s *Server) since(offset int64) []*prefabProto.Config {
+	var configs []*prefabProto.Config
+
+	for _, config := range s.configs {
+		if offset == 0 && len(config.GetRows()) == 0 {
+			continue
+		}
+
+		if config.GetId() > offset {
+			configs = append(configs, config)
+		}
+	}
+
+	return configs
+}
+
+// marshal wraps configs in a Configs envelope. The caller holds the mutex.
+func (s *Server) marshal(configs []*prefabProto.Config) ([]byte, error) {
+	return proto.Marshal(&prefabProto.Configs{
+		Configs:              configs,
+		ConfigServicePointer: &prefabProto.ConfigServicePointer{ProjectEnvId: s.projectEnvID},
+	})
+}
+
+// broadcast sends configs to every open stream. The caller holds the mutex.
+func (s *Server) broadcast(configs []*prefabProto.Config) {
+	if len(configs) == 0 {
+		return
+	}
+
+	payload, err := s.marshal(configs)
+	if err != nil {
+		panic(fmt.Sprintf("reforgetest: marshalling configs: %v", err))
+	}
+
+	for id, open := range s.streams {
+		select {
+		case open.events <- payload:
+		default:
+			// A client this far behind reconnects and catches up from its high watermark
+			close(open.drop)
+			delete(s.streams, id)
+		}
+	}
+}
+
+func (s *Server) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mutex.Lock()
+		latency := s.latency
+		failStatus := s.failStatus
+
+		if failStatus != 0 && s.failCount > 0 {
+			s.failCount--
+			if s.failCount == 0 {
+				s.failStatus = 0
+			}
+		}
+		s.mutex.Unlock()
+
+		if latency > 0 {
+			select {
+			case <-time.After(latency):
+			case <-r.Context().Done():
+				return
+			}
+		}
+
+		if failStatus != 0 {
+			http.Error(w, http.StatusText(failStatus), failStatus)
+
+			return
+		}
+
+		if r.Header.Get("Authorization") == "" {
+			http.Error(w, "missing SDK key", http.StatusUnauthorized)
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) handleConfigs(w http.ResponseWriter, r *http.Request) {
+	offset, err := strconv.ParseInt(strings.TrimPrefix(r.URL.Path, "/api/v2/configs/"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid offset", http.StatusBadRequest)
+
+		return
+	}
+
+	s.mutex.Lock()
+	payload, err := s.marshal(s.since(offset))
+	s.mutex.Unlock()
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	_, _ = w.Write(payload)
+}
+
+func (s *Server) handleStream(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+
+		return
+	}
+
+	startAt, _ := strconv.ParseInt(r.Header.Get("x-prefab-start-at-id"), 10, 64)
+
+	open := &stream{events: make(chan []byte, 64), drop: make(chan struct{})}
+
+	s.mutex.Lock()
+	id := s.nextStream
+	s.nextStream++
+	s.streamsOpened++
+	s.streams[id] = open
+
+	var initial []byte
+	if configs := s.since(startAt); len(configs) > 0 {
+		initial, _ = s.marshal(configs)
+	}
+	s.mutex.Unlock()
+
+	defer func() {
+		s.mutex.Lock()
+		delete(s.streams, id)
+		s.mutex.Unlock()
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	if initial != nil {
+		writeEvent(w, initial)
+	}
+
+	flusher.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-open.drop:
+			return
+		case payload := <-open.events:
+			writeEvent(w, payload)
+			flusher.Flush()
+		}
+	}
+}
+
+func writeEvent(w io.Writer, payload []byte) {
+	_, _ = fmt.Fprintf(w, "data: %s\n\n", base64.StdEncoding.EncodeToString(payload))
+}
+
+func (s *Server) handleTelemetry(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	var events prefabProto.TelemetryEvents
+	if err := proto.Unmarshal(body, &events); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	s.mutex.Lock()
+	s.telemetry = append(s.telemetry, &events)
+	s.mutex.Unlock()
+
+	w.WriteHeader(http.StatusOK)
+}
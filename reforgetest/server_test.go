@@ -0,0 +1,158 @@
+package reforgetest_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	reforge "github.com/ReforgeHQ/sdk-go"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+	"github.com/ReforgeHQ/sdk-go/reforgetest"
+)
+
+func newServerClient(t *testing.T, server *reforgetest.Server, opts ...reforge.Option) *reforge.Client {
+	t.Helper()
+
+	opts = append(server.Options(), append([]reforge.Option{reforge.WithInitializationTimeoutSeconds(5)}, opts...)...)
+
+	client, err := reforge.NewSdk(opts...)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = client.Close(context.Background())
+	})
+
+	return client
+}
+
+func eventuallyString(t *testing.T, client *reforge.Client, key string, expected string) {
+	t.Helper()
+
+	assert.Eventually(t, func() bool {
+		value, ok, err := client.GetStringValue(key, *reforge.NewContextSet())
+
+		return err == nil && ok && value == expected
+	}, 5*time.Second, 10*time.Millisecond, "%s never became %q", key, expected)
+}
+
+func TestServerServesAndStreamsConfigs(t *testing.T) {
+	server := reforgetest.NewServer(t, reforgetest.Config("greeting").Otherwise("hello").Build())
+	client := newServerClient(t, server, reforge.WithAllTelemetryDisabled())
+
+	eventuallyString(t, client, "greeting", "hello")
+	require.Eventually(t, func() bool { return server.OpenStreams() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	server.Push(reforgetest.Config("greeting").Otherwise("bonjour").Build())
+	eventuallyString(t, client, "greeting", "bonjour")
+
+	server.Delete("greeting")
+	assert.Eventually(t, func() bool {
+		_, ok, _ := client.GetStringValue("greeting", *reforge.NewContextSet())
+
+		return !ok
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestServerDroppedStreamReconnectsAndCatchesUp(t *testing.T) {
+	server := reforgetest.NewServer(t, reforgetest.Config("greeting").Otherwise("hello").Build())
+	client := newServerClient(t, server, reforge.WithAllTelemetryDisabled())
+
+	eventuallyString(t, client, "greeting", "hello")
+	require.Eventually(t, func() bool { return server.OpenStreams() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	server.FailRequests(http.StatusServiceUnavailable, 0)
+	server.DropStreams()
+	server.Push(reforgetest.Config("greeting").Otherwise("missed while down").Build())
+
+	server.FailRequests(0, 0)
+
+	eventuallyString(t, client, "greeting", "missed while down")
+	assert.GreaterOrEqual(t, server.StreamsOpened(), 2)
+}
+
+func TestServerInjectedFailuresAndLatency(t *testing.T) {
+	server := reforgetest.NewServer(t, reforgetest.Config("greeting").Otherwise("hello").Build())
+	server.FailRequests(http.StatusBadGateway, 1)
+	server.SetLatency(50 * time.Millisecond)
+
+	response, err := http.Get(server.URL() + "/api/v2/configs/0")
+	require.NoError(t, err)
+	response.Body.Close()
+	assert.Equal(t, http.StatusBadGateway, response.StatusCode)
+
+	started := time.Now()
+	response, err = http.Get(server.URL() + "/api/v2/configs/0")
+	require.NoError(t, err)
+	response.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, response.StatusCode)
+	assert.GreaterOrEqual(t, time.Since(started), 50*time.Millisecond)
+
+	server.SetLatency(0)
+	client := newServerClient(t, server, reforge.WithAllTelemetryDisabled())
+	eventuallyString(t, client, "greeting", "hello")
+}
+
+func TestServerRecordsTelemetry(t *testing.T) {
+	server := reforgetest.NewServer(t, reforgetest.Config("greeting").Otherwise("hello").Build())
+	client := newServerClient(t, server, reforge.WithCollectEvaluationSummaries(true))
+
+	eventuallyString(t, client, "greeting", "hello")
+	require.NoError(t, client.SendTelemetry(true))
+
+	var keys []string
+
+	for _, events := range server.TelemetryEvents() {
+		for _, event := range events.GetEvents() {
+			for _, summary := range event.GetSummaries().GetSummaries() {
+				keys = append(keys, summary.GetKey())
+			}
+		}
+	}
+
+	assert.Contains(t, keys, "greeting")
+}
+
+func TestServerSchemaViolationKeepsLastValidValue(t *testing.T) {
+	schema := reforgetest.Config("limits.schema").Otherwise(&prefabProto.ConfigValue{Type: &prefabProto.ConfigValue_Schema{Schema: &prefabProto.Schema{
+		SchemaType: prefabProto.Schema_JSON_SCHEMA,
+		Schema:     `{"type": "object", "required": ["daily"]}`,
+	}}}).Build()
+
+	limits := func(value map[string]interface{}) *prefabProto.Config {
+		config := reforgetest.Config("limits").Otherwise(value).Build()
+		config.SchemaKey = proto.String("limits.schema")
+
+		return config
+	}
+
+	server := reforgetest.NewServer(t, schema, limits(map[string]interface{}{"daily": 100}))
+	client := newServerClient(t, server, reforge.WithAllTelemetryDisabled())
+
+	violations := make(chan reforge.SchemaViolation, 1)
+	client.OnSchemaViolation(func(violation reforge.SchemaViolation) { violations <- violation })
+
+	value, ok, err := client.GetJSONValue("limits", *reforge.NewContextSet())
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, map[string]interface{}{"daily": float64(100)}, value)
+	require.Eventually(t, func() bool { return server.OpenStreams() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	server.Push(limits(map[string]interface{}{"weekly": 700}))
+
+	select {
+	case violation := <-violations:
+		assert.Equal(t, "limits", violation.Key)
+		assert.Equal(t, "limits.schema", violation.SchemaKey)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no schema violation was reported")
+	}
+
+	value, _, err = client.GetJSONValue("limits", *reforge.NewContextSet())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"daily": float64(100)}, value)
+}
@@ -0,0 +1,79 @@
+package reforge
+
+import (
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/ReforgeHQ/sdk-go/internal/stores"
+)
+
+// SchemaViolation reports a config update rejected because one of its JSON
+// values doesn't match the schema config named by its SchemaKey. The key
+// keeps serving its last valid version, or stays missing if it never had one.
+type SchemaViolation = stores.SchemaViolation
+
+// schemaViolationListeners holds the callbacks registered with OnSchemaViolation.
+type schemaViolationListeners struct {
+	listeners map[int]func(SchemaViolation)
+	nextID    int
+	mutex     sync.RWMutex
+}
+
+func newSchemaViolationListeners() *schemaViolationListeners {
+	return &schemaViolationListeners{listeners: make(map[int]func(SchemaViolation))}
+}
+
+func (sl *schemaViolationListeners) add(callback func(SchemaViolation)) func() {
+	sl.mutex.Lock()
+	defer sl.mutex.Unlock()
+
+	id := sl.nextID
+	sl.nextID++
+	sl.listeners[id] = callback
+
+	return func() {
+		sl.mutex.Lock()
+		defer sl.mutex.Unlock()
+
+		delete(sl.listeners, id)
+	}
+}
+
+func (sl *schemaViolationListeners) dispatch(violations []SchemaViolation) {
+	sl.mutex.RLock()
+	callbacks := make([]func(SchemaViolation), 0, len(sl.listeners))
+
+	for _, callback := range sl.listeners {
+		callbacks = append(callbacks, callback)
+	}
+	sl.mutex.RUnlock()
+
+	for _, violation := range violations {
+		for _, callback := range callbacks {
+			notifySchemaViolationListener(callback, violation)
+		}
+	}
+}
+
+func notifySchemaViolationListener(callback func(SchemaViolation), violation SchemaViolation) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error(fmt.Sprintf("schema violation listener for key %s panicked: %v", violation.Key, r))
+		}
+	}()
+
+	callback(violation)
+}
+
+// OnSchemaViolation registers a callback invoked for each config update
+// rejected by schema validation. JSON values of configs with a SchemaKey are
+// validated against that JSON_SCHEMA config whenever configs are loaded from
+// the API, its stream, the cache or a datafile, and again when their schema
+// changes; a bad edit is rejected rather than handed to the code parsing the
+// value. Callbacks run on the goroutine
+// applying the update, so they should return quickly. The returned func
+// unregisters the callback.
+func (c *Client) OnSchemaViolation(callback func(SchemaViolation)) func() {
+	return c.schemaViolations.add(callback)
+}
@@ -2,10 +2,13 @@
 package reforge
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ReforgeHQ/sdk-go/internal"
@@ -56,6 +59,10 @@ type ClientInterface interface {
 	GetFloatValueWithDefault(key string, contextSet ContextSet, defaultValue float64) (float64, bool)
 	GetStringSliceValueWithDefault(key string, contextSet ContextSet, defaultValue []string) ([]string, bool)
 	GetDurationWithDefault(key string, contextSet ContextSet, defaultValue time.Duration) (time.Duration, bool)
+	GetBytesValue(key string, contextSet ContextSet) ([]byte, bool, error)
+	GetBytesValueWithDefault(key string, contextSet ContextSet, defaultValue []byte) ([]byte, bool)
+	GetIntRangeValue(key string, contextSet ContextSet) (IntRange, bool, error)
+	GetIntRangeValueWithDefault(key string, contextSet ContextSet, defaultValue IntRange) (IntRange, bool)
 	GetLogLevelStringValue(key string, contextSet ContextSet) (string, bool, error)
 	GetLogLevel(loggerName string) LogLevel
 	GetJSONValue(key string, contextSet ContextSet) (interface{}, bool, error)
@@ -65,6 +72,9 @@ type ClientInterface interface {
 	FeatureIsOn(key string, contextSet ContextSet) (bool, bool)
 	WithContext(contextSet *ContextSet) *ContextBoundClient
 	GetInstanceHash() string
+	Keys() ([]string, error)
+	SendTelemetry(waitOnQueueToDrain bool) error
+	Close(ctx context.Context) error
 }
 
 // ContextBoundClient is a Client bound to a specific context. Any calls to the client will use the context provided.
@@ -81,15 +91,25 @@ type Client struct {
 	configResolver                  *internal.ConfigResolver
 	initializationComplete          chan struct{}
 	closeInitializationCompleteOnce sync.Once
+	initializationTimedOut          atomic.Bool
 	telemetry                       telemetry.Submitter
 	instanceHash                    string
+	closers                         []io.Closer
+	closed                          atomic.Bool
+	done                            chan struct{}
+	changeListeners                 *changeListeners
+	streamState                     *streamStateListeners
+	schemaViolations                *schemaViolationListeners
+	overrides                       *stores.OverrideConfigStore
 }
 
 // NewSdk creates a new Reforge SDK. It takes options as arguments (e.g. WithSdkKey)
 func NewSdk(opts ...Option) (*Client, error) {
 	options := optionsPkg.GetDefaultOptions()
 
-	var client Client
+	// The API source can finish loading before NewSdk returns, so the
+	// initialization channel must exist before any store is built.
+	client := Client{initializationComplete: make(chan struct{})}
 
 	for _, opt := range opts {
 		if err := opt(&options); err != nil {
@@ -99,6 +119,15 @@ func NewSdk(opts ...Option) (*Client, error) {
 
 	slog.Debug("Initializing client", "options", options)
 
+	// Validate before building any store; stores start goroutines as they are built
+	if (len(options.Sources) > 1 || (len(options.Sources) == 1 && options.Sources[0].Raw != optionsPkg.MemoryStoreKey)) && len(options.Configs) > 0 {
+		return nil, errors.New("cannot use WithConfigs with other sources")
+	}
+
+	if len(options.CustomStores) > 0 && len(options.Configs) > 0 {
+		return nil, errors.New("cannot use WithConfigs with custom stores")
+	}
+
 	var configStores []internal.ConfigStoreGetter
 
 	// Add custom stores first (they take precedence)
@@ -109,6 +138,7 @@ func NewSdk(opts ...Option) (*Client, error) {
 	}
 
 	apiSourceFinishedLoading := func() {
+		client.initializationTimedOut.Store(false)
 		client.closeInitializationCompleteOnce.Do(func() {
 			close(client.initializationComplete)
 		})
@@ -116,9 +146,20 @@ func NewSdk(opts ...Option) (*Client, error) {
 
 	anyAsync := false
 
+	var closers []io.Closer
+
+	listeners := newChangeListeners()
+	streamState := newStreamStateListeners()
+	schemaViolations := newSchemaViolationListeners()
+
 	for _, source := range options.Sources {
-		configStore, asyncInit, err := stores.BuildConfigStore(options, source, apiSourceFinishedLoading)
+		configStore, asyncInit, err := stores.BuildConfigStore(options, source, apiSourceFinishedLoading, listeners.dispatch, streamState.dispatch, schemaViolations.dispatch)
 		if err != nil {
+			// Stop the stores already built
+			for _, closer := range closers {
+				_ = closer.Close()
+			}
+
 			return nil, err
 		}
 
@@ -126,18 +167,16 @@ func NewSdk(opts ...Option) (*Client, error) {
 			anyAsync = true
 		}
 
-		configStores = append(configStores, configStore)
-	}
-
-	if (len(options.Sources) > 1 || (len(options.Sources) == 1 && options.Sources[0].Raw != optionsPkg.MemoryStoreKey)) && len(options.Configs) > 0 {
-		return nil, errors.New("cannot use WithConfigs with other sources")
-	}
+		if closer, ok := configStore.(io.Closer); ok {
+			closers = append(closers, closer)
+		}
 
-	if len(options.CustomStores) > 0 && len(options.Configs) > 0 {
-		return nil, errors.New("cannot use WithConfigs with custom stores")
+		configStores = append(configStores, configStore)
 	}
 
-	configStore := stores.BuildCompositeConfigStore(configStores...)
+	// Overrides take precedence over every other store
+	overrides := stores.NewOverrideConfigStore()
+	configStore := stores.BuildCompositeConfigStore(append([]internal.ConfigStoreGetter{overrides}, configStores...)...)
 
 	configResolver := internal.NewConfigResolver(configStore)
 
@@ -148,14 +187,17 @@ func NewSdk(opts ...Option) (*Client, error) {
 		}
 	}
 
-	client = Client{
-		options:                &options,
-		configStore:            configStore,
-		configResolver:         configResolver,
-		initializationComplete: make(chan struct{}),
-		telemetry:              *telemetry.NewTelemetrySubmitter(options),
-		instanceHash:           options.InstanceHash,
-	}
+	client.options = &options
+	client.configStore = configStore
+	client.overrides = overrides
+	client.configResolver = configResolver
+	client.telemetry = *telemetry.NewTelemetrySubmitter(options)
+	client.instanceHash = options.InstanceHash
+	client.closers = closers
+	client.changeListeners = listeners
+	client.streamState = streamState
+	client.schemaViolations = schemaViolations
+	client.done = make(chan struct{})
 
 	if !anyAsync {
 		client.closeInitializationCompleteOnce.Do(func() {
@@ -202,6 +244,16 @@ func (c *Client) GetDurationValue(key string, contextSet ContextSet) (value time
 	return c.boundClient.GetDurationValue(key, contextSet)
 }
 
+// GetBytesValue returns a bytes value for a given key and context
+func (c *Client) GetBytesValue(key string, contextSet ContextSet) (value []byte, ok bool, err error) {
+	return c.boundClient.GetBytesValue(key, contextSet)
+}
+
+// GetIntRangeValue returns an int range value for a given key and context
+func (c *Client) GetIntRangeValue(key string, contextSet ContextSet) (value IntRange, ok bool, err error) {
+	return c.boundClient.GetIntRangeValue(key, contextSet)
+}
+
 // GetJSONValue returns a JSON value for a given key and context
 func (c *Client) GetJSONValue(key string, contextSet ContextSet) (value interface{}, ok bool, err error) {
 	return c.boundClient.GetJSONValue(key, contextSet)
@@ -237,12 +289,23 @@ func (c *Client) GetDurationWithDefault(key string, contextSet ContextSet, defau
 	return c.boundClient.GetDurationWithDefault(key, contextSet, defaultValue)
 }
 
+// GetBytesValueWithDefault returns a bytes value for a given key and context, with a default value if the key does not exist
+func (c *Client) GetBytesValueWithDefault(key string, contextSet ContextSet, defaultValue []byte) (value []byte, wasFound bool) {
+	return c.boundClient.GetBytesValueWithDefault(key, contextSet, defaultValue)
+}
+
+// GetIntRangeValueWithDefault returns an int range value for a given key and context, with a default value if the key does not exist
+func (c *Client) GetIntRangeValueWithDefault(key string, contextSet ContextSet, defaultValue IntRange) (value IntRange, wasFound bool) {
+	return c.boundClient.GetIntRangeValueWithDefault(key, contextSet, defaultValue)
+}
+
 // GetJSONValueWithDefault returns a JSON value for a given key and context, with a default value if the key does not exist
 func (c *Client) GetJSONValueWithDefault(key string, contextSet ContextSet, defaultValue interface{}) (value interface{}, wasFound bool) {
 	return c.boundClient.GetJSONValueWithDefault(key, contextSet, defaultValue)
 }
 
 // FeatureIsOn returns a bool indicating if a feature is on for a given key and context. It will default to false if the key does not exist.
+// wasFound is only accurate with WithStrictEvaluation; otherwise it is always true.
 func (c *Client) FeatureIsOn(key string, contextSet ContextSet) (result bool, wasFound bool) {
 	return c.boundClient.FeatureIsOn(key, contextSet)
 }
@@ -259,6 +322,11 @@ func (c *Client) GetLogLevel(loggerName string) LogLevel {
 	return c.boundClient.GetLogLevel(loggerName)
 }
 
+// RecordLog counts a record logged by loggerName at level in logger telemetry. See ContextBoundClient.RecordLog.
+func (c *Client) RecordLog(loggerName string, level LogLevel) {
+	c.boundClient.RecordLog(loggerName, level)
+}
+
 // WithContext returns a new ContextBoundClient bound to the provided context (merged with the parent context)
 func (c *Client) WithContext(contextSet *ContextSet) *ContextBoundClient {
 	mergedContext := contexts.Merge(c.options.GlobalContext, contextSet)
@@ -278,32 +346,80 @@ func (c *Client) GetConfigMatch(key string, contextSet ContextSet) (*ConfigMatch
 
 // Keys returns a list of all keys in the config store
 func (c *Client) Keys() ([]string, error) {
-	if c.awaitInitialization() == timeout {
-		c.closeInitializationCompleteOnce.Do(func() {
-			close(c.initializationComplete)
-		})
+	if c.closed.Load() {
+		return []string{}, ErrClientClosed
+	}
 
-		if c.options.OnInitializationFailure == ReturnError {
-			return []string{}, errors.New("initialization timeout")
-		}
+	if c.awaitInitialization(context.Background()) == timeout && c.options.OnInitializationFailure == ReturnError {
+		return []string{}, ErrInitTimeout
 	}
 
 	return c.configResolver.Keys(), nil
 }
 
+// WaitForReady blocks until every source has finished its initial load. It
+// returns ErrInitTimeout if InitializationTimeoutSeconds elapsed before that
+// happened, or an error wrapping ctx.Err() if ctx is done first.
+func (c *Client) WaitForReady(ctx context.Context) error {
+	switch c.awaitInitialization(ctx) {
+	case timeout:
+		return ErrInitTimeout
+	case cancelled:
+		return fmt.Errorf("context done while waiting for initialization: %w", ctx.Err())
+	case success:
+	}
+
+	if c.initializationTimedOut.Load() {
+		return ErrInitTimeout
+	}
+
+	return nil
+}
+
 // GetInstanceHash returns the instance hash for the client
 func (c *Client) GetInstanceHash() string {
 	return c.instanceHash
 }
 
-func clientInternalGetValueFunc[T any](contextBoundClient *ContextBoundClient, key string, contextSet contexts.ContextSet, parseFunc func(*prefabProto.ConfigValue) (T, bool)) (T, bool, error) {
+// Close shuts the client down. It stops SSE reconnects, pending config fetch
+// retries and periodic telemetry submission, then flushes any queued telemetry
+// with a final submission that is abandoned once ctx is done. After Close,
+// evaluations return ErrClientClosed. Calling Close more than once is a no-op.
+func (c *Client) Close(ctx context.Context) error {
+	if !c.closed.CompareAndSwap(false, true) {
+		return nil
+	}
+
+	close(c.done)
+
+	var errs []error
+
+	for _, closer := range c.closers {
+		if err := closer.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Release any caller still blocked waiting on initialization
+	c.closeInitializationCompleteOnce.Do(func() {
+		close(c.initializationComplete)
+	})
+
+	if err := c.telemetry.Close(ctx); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+func clientInternalGetValueFunc[T any](ctx context.Context, contextBoundClient *ContextBoundClient, key string, contextSet contexts.ContextSet, parseFunc func(*prefabProto.ConfigValue) (T, bool)) (T, bool, error) {
 	var zeroValue T
 
 	mergedContextSet := *contexts.Merge(contextBoundClient.context, &contextSet)
 
 	contextBoundClient.client.telemetry.RecordContext(&mergedContextSet)
 
-	fetchResult, fetchOk, fetchErr := contextBoundClient.fetchAndProcessValue(key, mergedContextSet, func(cv *prefabProto.ConfigValue) (any, bool) {
+	fetchResult, fetchOk, fetchErr := contextBoundClient.fetchAndProcessValue(ctx, key, mergedContextSet, func(cv *prefabProto.ConfigValue) (any, bool) {
 		pVal, pOk := clientParseValueWrapper(cv, parseFunc)
 		if !pOk {
 			return nil, false
@@ -324,6 +440,10 @@ func clientInternalGetValueFunc[T any](contextBoundClient *ContextBoundClient, k
 	if !ok {
 		slog.Warn(fmt.Sprintf("unexpected type for %T value: %T", zeroValue, fetchResult))
 
+		if contextBoundClient.client.options.StrictEvaluation {
+			return zeroValue, false, fmt.Errorf("%w: %q is a %T, not %T", ErrTypeMismatch, key, fetchResult, zeroValue)
+		}
+
 		return zeroValue, false, nil
 	}
 
@@ -348,11 +468,22 @@ func (c *ContextBoundClient) SendTelemetry(waitOnQueueToDrain bool) error {
 	return c.client.telemetry.Submit(waitOnQueueToDrain)
 }
 
+// Keys returns a list of all keys in the config store
+func (c *ContextBoundClient) Keys() ([]string, error) {
+	return c.client.Keys()
+}
+
+// Close closes the underlying Client, and with it every ContextBoundClient
+// derived from it. See Client.Close.
+func (c *ContextBoundClient) Close(ctx context.Context) error {
+	return c.client.Close(ctx)
+}
+
 // GetIntValueWithDefault returns an int value for a given key and context, with a default value if the key does not exist
 func (c *ContextBoundClient) GetIntValueWithDefault(key string, contextSet contexts.ContextSet, defaultValue int64) (value int64, wasFound bool) {
 	value, ok, err := c.GetIntValue(key, contextSet)
 	if err != nil || !ok {
-		return defaultValue, true
+		return defaultValue, c.fallbackWasFound()
 	}
 
 	return value, ok
@@ -360,14 +491,14 @@ func (c *ContextBoundClient) GetIntValueWithDefault(key string, contextSet conte
 
 // GetIntValue returns an int value for a given key and context
 func (c *ContextBoundClient) GetIntValue(key string, contextSet contexts.ContextSet) (value int64, ok bool, err error) {
-	return clientInternalGetValueFunc(c, key, contextSet, utils.ExtractIntValue)
+	return clientInternalGetValueFunc(context.Background(), c, key, contextSet, utils.ExtractIntValue)
 }
 
 // GetStringValueWithDefault returns a string value for a given key and context, with a default value if the key does not exist
 func (c *ContextBoundClient) GetStringValueWithDefault(key string, contextSet contexts.ContextSet, defaultValue string) (value string, wasFound bool) {
 	value, ok, err := c.GetStringValue(key, contextSet)
 	if err != nil || !ok {
-		return defaultValue, true
+		return defaultValue, c.fallbackWasFound()
 	}
 
 	return value, ok
@@ -375,25 +506,32 @@ func (c *ContextBoundClient) GetStringValueWithDefault(key string, contextSet co
 
 // GetStringValue returns a string value for a given key and context
 func (c *ContextBoundClient) GetStringValue(key string, contextSet contexts.ContextSet) (value string, ok bool, err error) {
-	return clientInternalGetValueFunc(c, key, contextSet, utils.ExtractStringValue)
+	return clientInternalGetValueFunc(context.Background(), c, key, contextSet, utils.ExtractStringValue)
 }
 
 // GetJSONValue returns a JSON value for a given key and context
 func (c *ContextBoundClient) GetJSONValue(key string, contextSet contexts.ContextSet) (value interface{}, ok bool, err error) {
-	return clientInternalGetValueFunc(c, key, contextSet, utils.ExtractJSONValueWithoutError)
+	return clientInternalGetValueFunc(context.Background(), c, key, contextSet, utils.ExtractJSONValueWithoutError)
 }
 
 // GetJSONValueWithDefault returns a JSON value for a given key and context, with a default value if the key does not exist
 func (c *ContextBoundClient) GetJSONValueWithDefault(key string, contextSet contexts.ContextSet, defaultValue interface{}) (value interface{}, wasFound bool) {
 	value, ok, err := c.GetJSONValue(key, contextSet)
 	if err != nil || !ok {
-		return defaultValue, true
+		return defaultValue, c.fallbackWasFound()
 	}
 
 	return value, ok
 }
 
+// fallbackWasFound is the wasFound flag the WithDefault getters return when they fall back to the default.
+// It is true for compatibility unless WithStrictEvaluation is set.
+func (c *ContextBoundClient) fallbackWasFound() bool {
+	return !c.client.options.StrictEvaluation
+}
+
 // FeatureIsOn returns a bool indicating if a feature is on for a given key and context. It will default to false if the key does not exist.
+// wasFound is only accurate with WithStrictEvaluation; otherwise it is always true.
 func (c *ContextBoundClient) FeatureIsOn(key string, contextSet contexts.ContextSet) (result bool, wasFound bool) {
 	value, ok := c.GetBoolValueWithDefault(key, contextSet, false)
 
@@ -402,13 +540,7 @@ func (c *ContextBoundClient) FeatureIsOn(key string, contextSet contexts.Context
 
 // GetLogLevelStringValue returns a string value for a given key and context, representing a log level.
 func (c *ContextBoundClient) GetLogLevelStringValue(key string, contextSet contexts.ContextSet) (value string, ok bool, err error) {
-	rawValue, ok, err := clientInternalGetValueFunc(c, key, contextSet, utils.ExtractLogLevelValue)
-
-	if err != nil || !ok {
-		return "", false, err
-	}
-
-	return rawValue.String(), true, nil
+	return c.GetLogLevelStringValueCtx(context.Background(), key, contextSet)
 }
 
 // GetLogLevel returns the log level for a given logger name. It evaluates the logger key (from options)
@@ -443,11 +575,23 @@ func (c *ContextBoundClient) GetLogLevel(loggerName string) LogLevel {
 	return protoLogLevelToLogLevel(protoLogLevel)
 }
 
+// RecordLog counts a record logged by loggerName at level in logger telemetry,
+// which lets the Reforge UI list the logger for per-logger level targeting.
+// The logging integrations call it for every record they write; custom
+// integrations should do the same, and not count records filtered out by
+// level. It does nothing unless WithCollectLoggerCounts is true.
+func (c *ContextBoundClient) RecordLog(loggerName string, level LogLevel) {
+	// Unknown levels are counted as debug, like GetLogLevel's fallback
+	protoLevel, _ := logLevelToProtoLogLevel(level)
+
+	c.client.telemetry.RecordLog(loggerName, protoLevel)
+}
+
 // GetBoolValueWithDefault returns a bool value for a given key and context, with a default value if the key does not exist
 func (c *ContextBoundClient) GetBoolValueWithDefault(key string, contextSet contexts.ContextSet, defaultValue bool) (value bool, wasFound bool) {
 	value, ok, err := c.GetBoolValue(key, contextSet)
 	if err != nil || !ok {
-		return defaultValue, true
+		return defaultValue, c.fallbackWasFound()
 	}
 
 	return value, ok
@@ -455,14 +599,14 @@ func (c *ContextBoundClient) GetBoolValueWithDefault(key string, contextSet cont
 
 // GetBoolValue returns a bool value for a given key and context
 func (c *ContextBoundClient) GetBoolValue(key string, contextSet contexts.ContextSet) (value bool, ok bool, err error) {
-	return clientInternalGetValueFunc(c, key, contextSet, utils.ExtractBoolValue)
+	return clientInternalGetValueFunc(context.Background(), c, key, contextSet, utils.ExtractBoolValue)
 }
 
 // GetFloatValueWithDefault returns a float value for a given key and context, with a default value if the key does not exist
 func (c *ContextBoundClient) GetFloatValueWithDefault(key string, contextSet contexts.ContextSet, defaultValue float64) (value float64, wasFound bool) {
 	value, ok, err := c.GetFloatValue(key, contextSet)
 	if err != nil || !ok {
-		return defaultValue, true
+		return defaultValue, c.fallbackWasFound()
 	}
 
 	return value, ok
@@ -470,14 +614,14 @@ func (c *ContextBoundClient) GetFloatValueWithDefault(key string, contextSet con
 
 // GetFloatValue returns a float value for a given key and context
 func (c *ContextBoundClient) GetFloatValue(key string, contextSet contexts.ContextSet) (value float64, ok bool, err error) {
-	return clientInternalGetValueFunc(c, key, contextSet, utils.ExtractFloatValue)
+	return clientInternalGetValueFunc(context.Background(), c, key, contextSet, utils.ExtractFloatValue)
 }
 
 // GetStringSliceValueWithDefault returns a string slice value for a given key and context, with a default value if the key does not exist
 func (c *ContextBoundClient) GetStringSliceValueWithDefault(key string, contextSet contexts.ContextSet, defaultValue []string) (value []string, wasFound bool) {
 	value, ok, err := c.GetStringSliceValue(key, contextSet)
 	if err != nil || !ok {
-		return defaultValue, true
+		return defaultValue, c.fallbackWasFound()
 	}
 
 	return value, ok
@@ -485,14 +629,14 @@ func (c *ContextBoundClient) GetStringSliceValueWithDefault(key string, contextS
 
 // GetStringSliceValue returns a string slice value for a given key and context
 func (c *ContextBoundClient) GetStringSliceValue(key string, contextSet contexts.ContextSet) (value []string, ok bool, err error) {
-	return clientInternalGetValueFunc(c, key, contextSet, utils.ExtractStringListValue)
+	return clientInternalGetValueFunc(context.Background(), c, key, contextSet, utils.ExtractStringListValue)
 }
 
 // GetDurationWithDefault returns a duration value for a given key and context, with a default value if the key does not exist
 func (c *ContextBoundClient) GetDurationWithDefault(key string, contextSet contexts.ContextSet, defaultValue time.Duration) (value time.Duration, wasFound bool) {
 	value, ok, err := c.GetDurationValue(key, contextSet)
 	if err != nil || !ok {
-		return defaultValue, true
+		return defaultValue, c.fallbackWasFound()
 	}
 
 	return value, ok
@@ -500,21 +644,59 @@ func (c *ContextBoundClient) GetDurationWithDefault(key string, contextSet conte
 
 // GetDurationValue returns a duration value for a given key and context
 func (c *ContextBoundClient) GetDurationValue(key string, contextSet contexts.ContextSet) (value time.Duration, ok bool, err error) {
-	return clientInternalGetValueFunc(c, key, contextSet, utils.ExtractDurationValue)
+	return clientInternalGetValueFunc(context.Background(), c, key, contextSet, utils.ExtractDurationValue)
+}
+
+// GetBytesValueWithDefault returns a bytes value for a given key and context, with a default value if the key does not exist
+func (c *ContextBoundClient) GetBytesValueWithDefault(key string, contextSet contexts.ContextSet, defaultValue []byte) (value []byte, wasFound bool) {
+	value, ok, err := c.GetBytesValue(key, contextSet)
+	if err != nil || !ok {
+		return defaultValue, c.fallbackWasFound()
+	}
+
+	return value, ok
 }
 
-func (c *ContextBoundClient) fetchAndProcessValue(key string, contextSet contexts.ContextSet, parser utils.ExtractValueFunction) (any, bool, error) {
-	getResult, err := c.client.internalGetValue(key, contextSet)
+// GetBytesValue returns a bytes value for a given key and context
+func (c *ContextBoundClient) GetBytesValue(key string, contextSet contexts.ContextSet) (value []byte, ok bool, err error) {
+	return clientInternalGetValueFunc(context.Background(), c, key, contextSet, utils.ExtractBytesValue)
+}
+
+// GetIntRangeValueWithDefault returns an int range value for a given key and context, with a default value if the key does not exist
+func (c *ContextBoundClient) GetIntRangeValueWithDefault(key string, contextSet contexts.ContextSet, defaultValue IntRange) (value IntRange, wasFound bool) {
+	value, ok, err := c.GetIntRangeValue(key, contextSet)
+	if err != nil || !ok {
+		return defaultValue, c.fallbackWasFound()
+	}
+
+	return value, ok
+}
+
+// GetIntRangeValue returns an int range value for a given key and context
+func (c *ContextBoundClient) GetIntRangeValue(key string, contextSet contexts.ContextSet) (value IntRange, ok bool, err error) {
+	return clientInternalGetValueFunc(context.Background(), c, key, contextSet, extractIntRangeValue)
+}
+
+func (c *ContextBoundClient) fetchAndProcessValue(ctx context.Context, key string, contextSet contexts.ContextSet, parser utils.ExtractValueFunction) (any, bool, error) {
+	getResult, err := c.client.internalGetValue(ctx, key, contextSet)
 	if err != nil {
 		return nil, false, err
 	}
 
 	if getResult.match.Match == nil {
+		if c.client.options.StrictEvaluation {
+			return nil, false, fmt.Errorf("%w: no rule produced a value for %q", ErrKeyNotFound, key)
+		}
+
 		return nil, false, errors.New("config did not produce a result and no default is specified")
 	}
 
 	parsedValue, ok := parser(getResult.match.Match)
 	if !ok {
+		if c.client.options.StrictEvaluation {
+			return nil, false, fmt.Errorf("%w: %q holds a %s value", ErrTypeMismatch, key, utils.GetValueType(getResult.match.Match))
+		}
+
 		return nil, false, nil
 	}
 
@@ -535,8 +717,12 @@ func (c *ContextBoundClient) GetConfig(key string) (*prefabProto.Config, bool) {
 
 // GetConfigMatch returns a ConfigMatch object for a given key and context. You're unlikely to need this method.
 func (c *ContextBoundClient) GetConfigMatch(key string, contextSet ContextSet) (*ConfigMatch, error) {
+	return c.getConfigMatch(context.Background(), key, contextSet)
+}
+
+func (c *ContextBoundClient) getConfigMatch(ctx context.Context, key string, contextSet ContextSet) (*ConfigMatch, error) {
 	mergedContextSet := *contexts.Merge(c.context, &contextSet)
-	getResult, err := c.client.internalGetValue(key, mergedContextSet)
+	getResult, err := c.client.internalGetValue(ctx, key, mergedContextSet)
 	if err != nil {
 		return nil, err
 	}
@@ -549,19 +735,17 @@ func (c *ContextBoundClient) GetInstanceHash() string {
 	return c.client.GetInstanceHash()
 }
 
-func (c *Client) internalGetValue(key string, contextSet contexts.ContextSet) (resolutionResult, error) {
-	if c.awaitInitialization() == timeout {
-		c.closeInitializationCompleteOnce.Do(func() {
-			close(c.initializationComplete)
-		})
-
-		if c.options.OnInitializationFailure == optionsPkg.ReturnError {
-			return resolutionResultError(), errors.New("initialization timeout")
-		}
+func (c *Client) internalGetValue(ctx context.Context, key string, contextSet contexts.ContextSet) (resolutionResult, error) {
+	if err := c.readyForEvaluation(ctx); err != nil {
+		return resolutionResultError(), err
 	}
 
 	match, err := c.configResolver.ResolveValue(key, &contextSet)
 	if err != nil {
+		if c.options.StrictEvaluation && errors.Is(err, internal.ErrConfigDoesNotExist) {
+			err = fmt.Errorf("%w: %q", ErrKeyNotFound, key)
+		}
+
 		return resolutionResultError(), err
 	}
 
@@ -570,6 +754,29 @@ func (c *Client) internalGetValue(key string, contextSet contexts.ContextSet) (r
 	return resolutionResultSuccess(match), nil
 }
 
+// readyForEvaluation waits for initialization and reports whether an evaluation may proceed.
+func (c *Client) readyForEvaluation(ctx context.Context) error {
+	if c.closed.Load() {
+		return ErrClientClosed
+	}
+
+	switch c.awaitInitialization(ctx) {
+	case timeout:
+		if c.options.OnInitializationFailure == optionsPkg.ReturnError {
+			return ErrInitTimeout
+		}
+	case cancelled:
+		return fmt.Errorf("context done while waiting for initialization: %w", ctx.Err())
+	case success:
+	}
+
+	if c.closed.Load() {
+		return ErrClientClosed
+	}
+
+	return nil
+}
+
 type resolutionResultType int
 
 const (
@@ -607,13 +814,33 @@ func clientParseValueWrapper[T any](cv *prefabProto.ConfigValue, parseFunc func(
 	return zeroValue, false
 }
 
-func (c *Client) awaitInitialization() awaitInitializationResult {
+// awaitInitialization blocks until the sources have loaded, the configured
+// InitializationTimeoutSeconds elapses, or ctx is done, whichever comes first.
+// A completed initialization wins over a ctx that is already done. On timeout
+// the initialization channel is closed so later calls don't block again.
+func (c *Client) awaitInitialization(ctx context.Context) awaitInitializationResult {
 	select {
 	case <-c.initializationComplete:
 		return success
-	case <-time.After(time.Duration(c.options.InitializationTimeoutSeconds) * time.Second):
+	default:
+	}
+
+	timer := time.NewTimer(time.Duration(c.options.InitializationTimeoutSeconds * float64(time.Second)))
+	defer timer.Stop()
+
+	select {
+	case <-c.initializationComplete:
+		return success
+	case <-ctx.Done():
+		return cancelled
+	case <-timer.C:
 		slog.Warn(fmt.Sprintf("%f second timeout expired, proceeding without waiting further. Configure in options `InitializationTimeoutSeconds`", c.options.InitializationTimeoutSeconds))
 
+		c.closeInitializationCompleteOnce.Do(func() {
+			c.initializationTimedOut.Store(true)
+			close(c.initializationComplete)
+		})
+
 		return timeout
 	}
 }
@@ -623,9 +850,15 @@ type awaitInitializationResult int
 const (
 	success awaitInitializationResult = iota
 	timeout
+	cancelled
 )
 
 // ExtractValue extracts the underlying value from a ConfigValue. You're unlikely to need this method.
 func ExtractValue(cv *prefabProto.ConfigValue) (any, bool, error) {
-	return utils.ExtractValue(cv)
+	value, ok, err := utils.ExtractValue(cv)
+	if intRange, isIntRange := value.(*prefabProto.IntRange); isIntRange {
+		return intRangeFromProto(intRange), ok, err
+	}
+
+	return value, ok, err
 }
@@ -5,6 +5,13 @@ import (
 	"log/slog"
 )
 
+// logRecorder is the optional method ReforgeHandler counts records with.
+// Client has it; it isn't part of ClientInterface, so other implementations
+// don't need it.
+type logRecorder interface {
+	RecordLog(loggerName string, level LogLevel)
+}
+
 // ToSlogLevel converts a Reforge LogLevel to a slog.Level
 func (l LogLevel) ToSlogLevel() slog.Level {
 	switch l {
@@ -57,8 +64,13 @@ func (h *ReforgeHandler) Enabled(ctx context.Context, level slog.Level) bool {
 	return level >= reforgeLevel.ToSlogLevel()
 }
 
-// Handle handles the Record.
+// Handle handles the Record. If the client has a RecordLog method, as Client
+// does, the record is counted in logger telemetry.
 func (h *ReforgeHandler) Handle(ctx context.Context, r slog.Record) error {
+	if recorder, ok := h.client.(logRecorder); ok {
+		recorder.RecordLog(h.loggerName, logLevelFromSlogLevel(r.Level))
+	}
+
 	return h.wrappedHandler.Handle(ctx, r)
 }
 
@@ -82,8 +94,28 @@ func (h *ReforgeHandler) WithGroup(name string) slog.Handler {
 	}
 }
 
+// logLevelFromSlogLevel converts a slog.Level to the Reforge LogLevel whose
+// ToSlogLevel range contains it
+func logLevelFromSlogLevel(level slog.Level) LogLevel {
+	switch {
+	case level < slog.LevelDebug:
+		return Trace
+	case level < slog.LevelInfo:
+		return Debug
+	case level < slog.LevelWarn:
+		return Info
+	case level < slog.LevelError:
+		return Warn
+	case level < slog.LevelError+4:
+		return Error
+	default:
+		return Fatal
+	}
+}
+
 // ReforgeLeveler is a slog.Leveler that dynamically determines the log level
-// based on Reforge configuration.
+// based on Reforge configuration. It only sees levels, not records, so unlike
+// ReforgeHandler it doesn't count records in logger telemetry.
 type ReforgeLeveler struct {
 	client     ClientInterface
 	loggerName string
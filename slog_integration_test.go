@@ -3,12 +3,20 @@ package reforge
 import (
 	"bytes"
 	"context"
+	"io"
 	"log/slog"
+	"net/http"
+	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
 )
 
 func TestToSlogLevel(t *testing.T) {
@@ -276,3 +284,59 @@ func TestReforgeHandler_WithGroup(t *testing.T) {
 	lines := strings.Split(output, "\n")
 	assert.Greater(t, len(lines), 0, "Expected at least one line of output")
 }
+
+func TestReforgeHandler_CountsLogRecords(t *testing.T) {
+	var (
+		mutex    sync.Mutex
+		payloads []*prefabProto.TelemetryEvents
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		var payload prefabProto.TelemetryEvents
+		assert.NoError(t, proto.Unmarshal(body, &payload))
+
+		mutex.Lock()
+		payloads = append(payloads, &payload)
+		mutex.Unlock()
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := NewSdk(
+		WithSdkKey("test-key"),
+		WithOfflineSources([]string{"datafile://testdata/loglevel_test.json"}),
+		WithContextTelemetryMode(ContextTelemetryMode.None),
+		WithCollectEvaluationSummaries(false),
+		WithCollectLoggerCounts(true),
+		WithTelemetryHost(server.URL),
+		WithTelemetrySyncInterval(time.Hour),
+	)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+
+	logger := slog.New(NewReforgeHandler(client, slog.NewJSONHandler(&buf, nil), "com.example.error"))
+	logger.Info("filtered out, so not counted")
+	assert.True(t, logger.Enabled(context.Background(), slog.LevelError), "level checks aren't counted")
+	logger.Error("logged")
+	logger.Log(context.Background(), slog.LevelError+4, "fatal")
+
+	require.NoError(t, client.Close(context.Background()))
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	require.Len(t, payloads, 1)
+	require.Len(t, payloads[0].GetEvents(), 1)
+
+	loggers := payloads[0].GetEvents()[0].GetLoggers().GetLoggers()
+	require.Len(t, loggers, 1)
+	assert.Equal(t, "com.example.error", loggers[0].GetLoggerName())
+	assert.Nil(t, loggers[0].Infos)
+	assert.Equal(t, int64(1), loggers[0].GetErrors())
+	assert.Equal(t, int64(1), loggers[0].GetFatals())
+}
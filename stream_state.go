@@ -0,0 +1,108 @@
+package reforge
+
+import (
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/ReforgeHQ/sdk-go/internal/sse"
+)
+
+// StreamState is the state of the SSE connection that delivers config updates.
+type StreamState = sse.ConnectionState
+
+const (
+	// StreamConnecting means a connection attempt is in progress
+	StreamConnecting = sse.Connecting
+	// StreamConnected means the stream is open and receiving updates
+	StreamConnected = sse.Connected
+	// StreamDisconnected means the stream failed or was closed and will be retried
+	StreamDisconnected = sse.Disconnected
+)
+
+// StreamStateEvent reports a change in the SSE connection.
+type StreamStateEvent struct {
+	// Err is the reason a stream was disconnected, if there was one
+	Err error
+	// URL is the stream URL the event refers to
+	URL   string
+	State StreamState
+}
+
+// streamStateListeners tracks the current stream state and the callbacks
+// registered with OnStreamStateChange.
+type streamStateListeners struct {
+	listeners map[int]func(StreamStateEvent)
+	current   StreamStateEvent
+	nextID    int
+	mutex     sync.RWMutex
+}
+
+func newStreamStateListeners() *streamStateListeners {
+	return &streamStateListeners{listeners: make(map[int]func(StreamStateEvent))}
+}
+
+func (sl *streamStateListeners) add(callback func(StreamStateEvent)) func() {
+	sl.mutex.Lock()
+	defer sl.mutex.Unlock()
+
+	id := sl.nextID
+	sl.nextID++
+	sl.listeners[id] = callback
+
+	return func() {
+		sl.mutex.Lock()
+		defer sl.mutex.Unlock()
+
+		delete(sl.listeners, id)
+	}
+}
+
+func (sl *streamStateListeners) dispatch(state sse.ConnectionState, url string, err error) {
+	event := StreamStateEvent{State: state, URL: url, Err: err}
+
+	sl.mutex.Lock()
+	sl.current = event
+	callbacks := make([]func(StreamStateEvent), 0, len(sl.listeners))
+
+	for _, callback := range sl.listeners {
+		callbacks = append(callbacks, callback)
+	}
+	sl.mutex.Unlock()
+
+	for _, callback := range callbacks {
+		notifyStreamStateListener(callback, event)
+	}
+}
+
+func (sl *streamStateListeners) state() StreamStateEvent {
+	sl.mutex.RLock()
+	defer sl.mutex.RUnlock()
+
+	return sl.current
+}
+
+func notifyStreamStateListener(callback func(StreamStateEvent), event StreamStateEvent) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error(fmt.Sprintf("stream state listener panicked: %v", r))
+		}
+	}()
+
+	callback(event)
+}
+
+// OnStreamStateChange registers a callback invoked whenever the SSE
+// connection starts connecting, connects or disconnects, including failover
+// between API URLs. Callbacks run on the streaming goroutine, so they should
+// return quickly. The returned func unregisters the callback.
+func (c *Client) OnStreamStateChange(callback func(StreamStateEvent)) func() {
+	return c.streamState.add(callback)
+}
+
+// StreamState returns the most recent SSE connection state. The State is zero
+// until the first connection attempt, and stays zero for clients that don't
+// stream (offline sources or WithPollingInterval).
+func (c *Client) StreamState() StreamStateEvent {
+	return c.streamState.state()
+}
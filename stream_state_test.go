@@ -0,0 +1,35 @@
+package reforge
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOnStreamStateChange(t *testing.T) {
+	client := &Client{streamState: newStreamStateListeners()}
+
+	assert.Equal(t, StreamState(0), client.StreamState().State)
+
+	var events []StreamStateEvent
+
+	unsubscribe := client.OnStreamStateChange(func(event StreamStateEvent) {
+		events = append(events, event)
+	})
+	client.OnStreamStateChange(func(StreamStateEvent) { panic("listener panics are recovered") })
+
+	failure := errors.New("boom")
+	client.streamState.dispatch(StreamConnecting, "https://a", nil)
+	client.streamState.dispatch(StreamDisconnected, "https://a", failure)
+
+	unsubscribe()
+	client.streamState.dispatch(StreamConnected, "https://b", nil)
+
+	assert.Equal(t, []StreamStateEvent{
+		{State: StreamConnecting, URL: "https://a"},
+		{State: StreamDisconnected, URL: "https://a", Err: failure},
+	}, events)
+	assert.Equal(t, StreamStateEvent{State: StreamConnected, URL: "https://b"}, client.StreamState())
+	assert.Equal(t, "CONNECTED", client.StreamState().State.String())
+}
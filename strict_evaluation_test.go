@@ -0,0 +1,104 @@
+package reforge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+func newStrictEvaluationTestClient(t *testing.T, strict bool) *Client {
+	t.Helper()
+
+	secret := staticConfig(t, "api.token", "deadbeef--cafe--f00d")
+	secret.Rows[0].Values[0].Value.DecryptWith = stringPtr("secret.key")
+
+	store := &mutableTestStore{configs: map[string]*prefabProto.Config{}}
+	store.set(staticConfig(t, "feature.on", true))
+	store.set(staticConfig(t, "feature.name", "checkout"))
+	store.set(staticConfig(t, "secret.key", "not-hex"))
+	store.set(secret)
+	store.set(&prefabProto.Config{Key: "no.rows.match", Rows: []*prefabProto.ConfigRow{{
+		Values: []*prefabProto.ConditionalValue{{
+			Criteria: []*prefabProto.Criterion{{Operator: prefabProto.Criterion_NOT_SET}},
+			Value:    &prefabProto.ConfigValue{Type: &prefabProto.ConfigValue_Bool{Bool: true}},
+		}},
+	}}})
+
+	client, err := NewSdk(
+		WithCustomStore(store),
+		WithOfflineSources([]string{}),
+		WithAllTelemetryDisabled(),
+		WithStrictEvaluation(strict),
+	)
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestStrictEvaluationReportsAccurateWasFound(t *testing.T) {
+	client := newStrictEvaluationTestClient(t, true)
+	contextSet := *NewContextSet()
+
+	value, wasFound := client.GetStringValueWithDefault("feature.name", contextSet, "default")
+	assert.Equal(t, "checkout", value)
+	assert.True(t, wasFound)
+
+	value, wasFound = client.GetStringValueWithDefault("missing.key", contextSet, "default")
+	assert.Equal(t, "default", value)
+	assert.False(t, wasFound)
+
+	intValue, wasFound := client.GetIntValueWithDefault("feature.name", contextSet, 42)
+	assert.Equal(t, int64(42), intValue)
+	assert.False(t, wasFound)
+
+	on, wasFound := client.FeatureIsOn("feature.on", contextSet)
+	assert.True(t, on)
+	assert.True(t, wasFound)
+
+	on, wasFound = client.FeatureIsOn("missing.key", contextSet)
+	assert.False(t, on)
+	assert.False(t, wasFound)
+}
+
+func TestStrictEvaluationReturnsSentinelErrors(t *testing.T) {
+	client := newStrictEvaluationTestClient(t, true)
+	contextSet := *NewContextSet()
+
+	_, ok, err := client.GetBoolValue("missing.key", contextSet)
+	require.ErrorIs(t, err, ErrKeyNotFound)
+	assert.False(t, ok)
+
+	_, _, err = client.GetBoolValue("no.rows.match", contextSet)
+	require.ErrorIs(t, err, ErrKeyNotFound)
+
+	_, ok, err = client.GetIntValue("feature.name", contextSet)
+	require.ErrorIs(t, err, ErrTypeMismatch)
+	assert.False(t, ok)
+
+	_, _, err = client.GetStringValue("api.token", contextSet)
+	require.ErrorIs(t, err, ErrDecryption)
+}
+
+func TestDefaultEvaluationKeepsOriginalBehavior(t *testing.T) {
+	client := newStrictEvaluationTestClient(t, false)
+	contextSet := *NewContextSet()
+
+	value, wasFound := client.GetStringValueWithDefault("missing.key", contextSet, "default")
+	assert.Equal(t, "default", value)
+	assert.True(t, wasFound)
+
+	on, wasFound := client.FeatureIsOn("missing.key", contextSet)
+	assert.False(t, on)
+	assert.True(t, wasFound)
+
+	_, ok, err := client.GetIntValue("feature.name", contextSet)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, _, err = client.GetBoolValue("missing.key", contextSet)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrKeyNotFound)
+}
@@ -0,0 +1,202 @@
+package reforge
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ReforgeHQ/sdk-go/internal/utils"
+)
+
+// WatchUpdate is a value delivered by Watch.
+type WatchUpdate struct {
+	// Value is the evaluated value as returned by ExtractValue (int64, string, bool, float64, []string,
+	// time.Duration, IntRange or a decoded JSON value). It is nil when Found is false.
+	Value any
+	// Match is the full evaluation result. It is nil when Found is false.
+	Match *ConfigMatch
+	Key   string
+	// Found is false when the key does not exist or did not produce a value for the bound context.
+	Found bool
+}
+
+func (u WatchUpdate) sameValueAs(other WatchUpdate) bool {
+	if u.Found != other.Found {
+		return false
+	}
+
+	if !u.Found {
+		return true
+	}
+
+	return proto.Equal(u.Match.Match, other.Match.Match)
+}
+
+// Watch evaluates key against the global context and reports changes. See ContextBoundClient.Watch.
+func (c *Client) Watch(ctx context.Context, key string) (<-chan WatchUpdate, error) {
+	return c.boundClient.Watch(ctx, key)
+}
+
+// Watch returns a channel that receives the value of key evaluated against
+// the bound context. The current value is sent first; after that an update is
+// sent only when a store change alters the value evaluated for this context,
+// so edits to rules that don't apply here are not reported. If the consumer
+// falls behind, intermediate values are skipped and only the latest is
+// delivered. The channel is closed when ctx is done or the client is closed.
+//
+// Watch evaluations are not recorded in telemetry.
+func (c *ContextBoundClient) Watch(ctx context.Context, key string) (<-chan WatchUpdate, error) {
+	if c.client.closed.Load() {
+		return nil, ErrClientClosed
+	}
+
+	updates := make(chan WatchUpdate, 1)
+	changed := make(chan struct{}, 1)
+
+	// Subscribe before the first evaluation so a change landing in between isn't missed
+	unsubscribe := c.client.OnAnyChange(func(ChangeEvent) {
+		select {
+		case changed <- struct{}{}:
+		default:
+			// an evaluation is already pending
+		}
+	})
+
+	go func() {
+		defer close(updates)
+		defer unsubscribe()
+
+		var last *WatchUpdate
+
+		for {
+			update := c.evaluateForWatch(key)
+
+			if last == nil || !update.sameValueAs(*last) {
+				select {
+				case updates <- update:
+					last = &update
+				case <-ctx.Done():
+					return
+				case <-c.client.done:
+					return
+				}
+			}
+
+			select {
+			case <-changed:
+			case <-ctx.Done():
+				return
+			case <-c.client.done:
+				return
+			}
+		}
+	}()
+
+	return updates, nil
+}
+
+// WatchAs is Watch with values of type T. The current value is sent first
+// unless the key is missing; a key that is missing or no longer produces a
+// value sends nothing. If key currently holds a value of another type, an
+// error wrapping ErrTypeMismatch is returned; values of another type arriving
+// later are skipped with a warning.
+//
+//	batchSizes, err := reforge.WatchAs[int64](ctx, client.WithContext(consumerContext), "kafka.batch.size")
+func WatchAs[T ValueType](ctx context.Context, client BoundClient, key string) (<-chan T, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	updates, err := client.contextBoundClient().Watch(ctx, key)
+	if err != nil {
+		cancel()
+
+		return nil, err
+	}
+
+	extract := extractorFor[T]()
+
+	first, open := <-updates
+	if !open {
+		// the channel closes early when ctx is done or the client closed
+		err := ctx.Err()
+
+		cancel()
+
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, ErrClientClosed
+	}
+
+	if first.Found {
+		if _, ok := extract(first.Match.Match); !ok {
+			cancel()
+
+			var zero T
+
+			return nil, fmt.Errorf("%w: %q holds a %s value, not %T", ErrTypeMismatch, key, utils.GetValueType(first.Match.Match), zero)
+		}
+	}
+
+	values := make(chan T, 1)
+
+	// send delivers update if it holds a T and reports whether to keep going
+	send := func(update WatchUpdate) bool {
+		if !update.Found {
+			return true
+		}
+
+		value, ok := extract(update.Match.Match)
+		if !ok {
+			var zero T
+
+			slog.Warn(fmt.Sprintf("watch of %s skipped a %s value, not %T", key, utils.GetValueType(update.Match.Match), zero))
+
+			return true
+		}
+
+		select {
+		case values <- value:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	go func() {
+		defer cancel()
+		defer close(values)
+
+		if !send(first) {
+			return
+		}
+
+		for update := range updates {
+			if !send(update) {
+				return
+			}
+		}
+	}()
+
+	return values, nil
+}
+
+func (c *ContextBoundClient) evaluateForWatch(key string) WatchUpdate {
+	match, err := c.client.configResolver.ResolveValue(key, c.context)
+	if err != nil || !match.IsMatch || match.Match == nil {
+		return WatchUpdate{Key: key}
+	}
+
+	value, _, err := ExtractValue(match.Match)
+	if err != nil {
+		return WatchUpdate{Key: key}
+	}
+
+	return WatchUpdate{Key: key, Value: value, Match: &match, Found: true}
+}
@@ -0,0 +1,246 @@
+package reforge
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ReforgeHQ/sdk-go/internal/stores"
+	"github.com/ReforgeHQ/sdk-go/internal/utils"
+	prefabProto "github.com/ReforgeHQ/sdk-go/proto"
+)
+
+type mutableTestStore struct {
+	configs map[string]*prefabProto.Config
+	mutex   sync.RWMutex
+}
+
+func (s *mutableTestStore) GetConfig(key string) (*prefabProto.Config, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	config, exists := s.configs[key]
+
+	return config, exists
+}
+
+func (s *mutableTestStore) Keys() []string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	keys := make([]string, 0, len(s.configs))
+	for key := range s.configs {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+func (s *mutableTestStore) GetContextValue(string) (interface{}, bool) {
+	return nil, false
+}
+
+func (s *mutableTestStore) GetProjectEnvID() int64 {
+	return 0
+}
+
+func (s *mutableTestStore) set(config *prefabProto.Config) stores.ConfigChange {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	old := s.configs[config.GetKey()]
+	s.configs[config.GetKey()] = config
+
+	return stores.ConfigChange{Key: config.GetKey(), Old: old, New: config}
+}
+
+func tieredConfig(t *testing.T, id int64, goldValue int64, defaultValue int64) *prefabProto.Config {
+	t.Helper()
+
+	gold, ok := utils.Create(goldValue)
+	require.True(t, ok)
+
+	fallback, ok := utils.Create(defaultValue)
+	require.True(t, ok)
+
+	tiers, ok := utils.Create([]string{"gold"})
+	require.True(t, ok)
+
+	return &prefabProto.Config{
+		Id:  id,
+		Key: "kafka.batch.size",
+		Rows: []*prefabProto.ConfigRow{{
+			Values: []*prefabProto.ConditionalValue{
+				{
+					Criteria: []*prefabProto.Criterion{{
+						PropertyName: "user.tier",
+						Operator:     prefabProto.Criterion_PROP_IS_ONE_OF,
+						ValueToMatch: tiers,
+					}},
+					Value: gold,
+				},
+				{Value: fallback},
+			},
+		}},
+	}
+}
+
+func receiveUpdate(t *testing.T, updates <-chan WatchUpdate) WatchUpdate {
+	t.Helper()
+
+	select {
+	case update, ok := <-updates:
+		require.True(t, ok, "channel closed unexpectedly")
+
+		return update
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for watch update")
+	}
+
+	return WatchUpdate{}
+}
+
+func assertNoUpdate(t *testing.T, updates <-chan WatchUpdate) {
+	t.Helper()
+
+	select {
+	case update := <-updates:
+		t.Fatalf("unexpected watch update %+v", update)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestWatchEmitsOnlyWhenTheBoundValueChanges(t *testing.T) {
+	store := &mutableTestStore{configs: map[string]*prefabProto.Config{}}
+	store.set(tieredConfig(t, 1, 500, 100))
+
+	client, err := NewSdk(WithCustomStore(store), WithOfflineSources([]string{}), WithAllTelemetryDisabled())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	silver := NewContextSet().WithNamedContextValues("user", map[string]interface{}{"tier": "silver"})
+
+	updates, err := client.WithContext(silver).Watch(ctx, "kafka.batch.size")
+	require.NoError(t, err)
+
+	update := receiveUpdate(t, updates)
+	assert.True(t, update.Found)
+	assert.Equal(t, int64(100), update.Value)
+
+	// The gold rule doesn't apply to this context, so there's nothing to report
+	client.changeListeners.dispatch([]stores.ConfigChange{store.set(tieredConfig(t, 2, 1000, 100))})
+	assertNoUpdate(t, updates)
+
+	client.changeListeners.dispatch([]stores.ConfigChange{store.set(tieredConfig(t, 3, 1000, 250))})
+	update = receiveUpdate(t, updates)
+	assert.Equal(t, int64(250), update.Value)
+	assert.Equal(t, int64(3), update.Match.ConfigID)
+
+	cancel()
+
+	select {
+	case _, ok := <-updates:
+		assert.False(t, ok, "channel should be closed after ctx is done")
+	case <-time.After(time.Second):
+		t.Fatal("channel was not closed after ctx was done")
+	}
+}
+
+func TestWatchReportsMissingKeysAndStopsOnClose(t *testing.T) {
+	store := &mutableTestStore{configs: map[string]*prefabProto.Config{}}
+
+	client, err := NewSdk(WithCustomStore(store), WithOfflineSources([]string{}), WithAllTelemetryDisabled())
+	require.NoError(t, err)
+
+	updates, err := client.Watch(context.Background(), "kafka.batch.size")
+	require.NoError(t, err)
+
+	update := receiveUpdate(t, updates)
+	assert.False(t, update.Found)
+	assert.Nil(t, update.Value)
+
+	client.changeListeners.dispatch([]stores.ConfigChange{store.set(tieredConfig(t, 1, 500, 100))})
+	update = receiveUpdate(t, updates)
+	assert.True(t, update.Found)
+	assert.Equal(t, int64(100), update.Value)
+
+	require.NoError(t, client.Close(context.Background()))
+
+	select {
+	case _, ok := <-updates:
+		assert.False(t, ok, "channel should be closed after the client is closed")
+	case <-time.After(time.Second):
+		t.Fatal("channel was not closed after Close")
+	}
+
+	_, err = client.Watch(context.Background(), "kafka.batch.size")
+	require.ErrorIs(t, err, ErrClientClosed)
+}
+
+func TestWatchAs(t *testing.T) {
+	store := &mutableTestStore{configs: map[string]*prefabProto.Config{}}
+	store.set(tieredConfig(t, 1, 500, 100))
+	store.set(staticConfig(t, "kafka.topic", "orders"))
+
+	client, err := NewSdk(WithCustomStore(store), WithOfflineSources([]string{}), WithAllTelemetryDisabled())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	batchSizes, err := WatchAs[int64](ctx, client, "kafka.batch.size")
+	require.NoError(t, err)
+
+	receive := func() int64 {
+		t.Helper()
+
+		select {
+		case value := <-batchSizes:
+			return value
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for watch value")
+		}
+
+		return 0
+	}
+
+	assert.Equal(t, int64(100), receive())
+
+	client.changeListeners.dispatch([]stores.ConfigChange{store.set(tieredConfig(t, 2, 500, 250))})
+	assert.Equal(t, int64(250), receive())
+
+	t.Run("skips values of another type", func(t *testing.T) {
+		client.changeListeners.dispatch([]stores.ConfigChange{store.set(staticConfig(t, "kafka.batch.size", "large"))})
+		client.changeListeners.dispatch([]stores.ConfigChange{store.set(tieredConfig(t, 3, 500, 300))})
+
+		assert.Equal(t, int64(300), receive())
+	})
+
+	t.Run("rejects a key holding another type", func(t *testing.T) {
+		_, err := WatchAs[int64](ctx, client, "kafka.topic")
+		require.ErrorIs(t, err, ErrTypeMismatch)
+	})
+
+	cancel()
+
+	select {
+	case _, ok := <-batchSizes:
+		assert.False(t, ok, "channel should be closed after ctx is done")
+	case <-time.After(time.Second):
+		t.Fatal("channel was not closed after ctx was done")
+	}
+
+	_, err = WatchAs[int64](ctx, client, "kafka.batch.size")
+	require.ErrorIs(t, err, context.Canceled)
+
+	require.NoError(t, client.Close(context.Background()))
+
+	_, err = WatchAs[int64](context.Background(), client, "kafka.batch.size")
+	require.ErrorIs(t, err, ErrClientClosed)
+}
@@ -0,0 +1,48 @@
+package reforge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestYAMLDatafileTargetingRules(t *testing.T) {
+	client, err := NewSdk(
+		WithOfflineSources([]string{"datafile://testdata/targeting_rules.yaml"}),
+		WithAllTelemetryDisabled(),
+	)
+	require.NoError(t, err)
+
+	userContext := func(values map[string]interface{}) ContextSet {
+		return *NewContextSet().WithNamedContextValues("user", values)
+	}
+
+	flagTests := []struct {
+		name     string
+		context  ContextSet
+		expected bool
+	}{
+		{name: "criteria match", context: userContext(map[string]interface{}{"email": "me@example.com"}), expected: true},
+		{name: "segment member gets the rollout", context: userContext(map[string]interface{}{"key": "u1", "plan": "beta"}), expected: true},
+		{name: "everyone else", context: userContext(map[string]interface{}{"key": "u2", "plan": "free"}), expected: false},
+	}
+
+	for _, tt := range flagTests {
+		t.Run(tt.name, func(t *testing.T) {
+			enabled, ok, err := client.GetBoolValue("checkout.new-flow", tt.context)
+			require.NoError(t, err)
+			assert.True(t, ok)
+			assert.Equal(t, tt.expected, enabled)
+		})
+	}
+
+	// The file's _project_env_id selects which environment rows apply
+	banner, _, err := client.GetStringValue("checkout.banner", userContext(map[string]interface{}{"plan": "enterprise"}))
+	require.NoError(t, err)
+	assert.Equal(t, "enterprise banner", banner)
+
+	banner, _, err = client.GetStringValue("checkout.banner", userContext(map[string]interface{}{"plan": "free"}))
+	require.NoError(t, err)
+	assert.Equal(t, "default banner", banner)
+}